@@ -0,0 +1,107 @@
+package lst
+
+import (
+	"sync/atomic"
+)
+
+// An Atom is a reference to a list which can be shared by several goroutines.
+// Since lists are persistent, updating it is just replacing the list it
+// references, which is done atomically, without locks. The zero value is an
+// atom referencing a nil list, ready to use; NewAtom creates one referencing a
+// given list.
+//
+// Example:
+//
+// queue := NewAtom(New())
+// queue.Swap(func(l *List) *List {
+// 	return Snoc(l, job)
+// })
+type Atom struct {
+	list atomic.Pointer[List]
+	// A list of *watcher, the most recently added first. Nil when no watcher
+	// was ever added.
+	watchers atomic.Pointer[List]
+}
+
+type watcher struct {
+	f func(old, updated *List)
+}
+
+func NewAtom(l *List) *Atom {
+	a := new(Atom)
+	a.list.Store(l)
+	return a
+}
+
+// Load gives the list currently referenced by the atom.
+func (a *Atom) Load() *List {
+	return a.list.Load()
+}
+
+// Swap replaces the list referenced by the atom with the one f gives for it,
+// returning both. If another goroutine replaces the list in the meantime, f
+// is called again with the new one, so it must not have side effects.
+//
+// Example:
+//
+// old, _ := queue.Swap(func(l *List) *List {
+// 	if Empty(l) {
+// 		return l
+// 	}
+// 	return Tail(l)
+// })
+// job, ok := HeadOK(old)
+func (a *Atom) Swap(f func(*List) *List) (old, updated *List) {
+	for {
+		old = a.list.Load()
+		updated = f(old)
+		if a.CompareAndSwap(old, updated) {
+			return
+		}
+	}
+}
+
+// CompareAndSwap replaces the list referenced by the atom with updated, but
+// only if it still references old. It tells if the list was replaced.
+func (a *Atom) CompareAndSwap(old, updated *List) bool {
+	if !a.list.CompareAndSwap(old, updated) {
+		return false
+	}
+
+	if ws := a.watchers.Load(); ws != nil {
+		for _, w := range Backward(ws) {
+			w.(*watcher).f(old, updated)
+		}
+	}
+	return true
+}
+
+// Watch registers a function to be called with the old and the new lists
+// whenever the list referenced by the atom is replaced. It's called by the
+// goroutine replacing the list, right after doing it, so it must be quick, and
+// concurrent replacements may be notified out of order. Watchers are called
+// in the order they were registered. The returned function unregisters it.
+func (a *Atom) Watch(f func(old, updated *List)) (unwatch func()) {
+	w := &watcher{f}
+	update(&a.watchers, func(ws *List) *List {
+		if ws == nil {
+			return L(w)
+		}
+		return Cons(w, ws)
+	})
+
+	return func() {
+		update(&a.watchers, func(ws *List) *List {
+			return Delete(w, ws)
+		})
+	}
+}
+
+func update(p *atomic.Pointer[List], f func(*List) *List) {
+	for {
+		old := p.Load()
+		if p.CompareAndSwap(old, f(old)) {
+			return
+		}
+	}
+}
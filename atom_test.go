@@ -0,0 +1,107 @@
+package lst
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtom(t *testing.T) {
+	a := NewAtom(L(1, 2))
+
+	old, updated := a.Swap(func(l *List) *List {
+		return Cons(0, l)
+	})
+	if !Equal(old, L(1, 2)) || !Equal(updated, L(0, 1, 2)) || a.Load() != updated {
+		t.Errorf("Swap gave %v and %v", old, updated)
+	}
+
+	if a.CompareAndSwap(old, New()) {
+		t.Error("CompareAndSwap shouldn't replace a list other than the current one")
+	}
+
+	if !a.CompareAndSwap(updated, L(3)) || !Equal(a.Load(), L(3)) {
+		t.Errorf("CompareAndSwap gave %v", a.Load())
+	}
+}
+
+func TestAtomConcurrently(t *testing.T) {
+	a := NewAtom(New())
+	const goroutines = 8
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < N; i++ {
+				a.Swap(func(l *List) *List {
+					return Cons(i, l)
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if Len(a.Load()) != goroutines*N {
+		t.Errorf("The atom has %d elements instead of %d", Len(a.Load()), goroutines*N)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	a := NewAtom(New())
+
+	var calls []string
+	unwatch := a.Watch(func(old, updated *List) {
+		calls = append(calls, "first "+old.String()+" "+updated.String())
+	})
+	a.Watch(func(old, updated *List) {
+		calls = append(calls, "second")
+	})
+
+	a.Swap(func(l *List) *List {
+		return Cons(1, l)
+	})
+	unwatch()
+	a.CompareAndSwap(a.Load(), New())
+	a.CompareAndSwap(L(9), L(10))
+
+	expected := []string{"first [] [1]", "second", "second"}
+	if len(calls) != len(expected) {
+		t.Fatalf("The watchers were called as %v", calls)
+	}
+
+	for i := range calls {
+		if calls[i] != expected[i] {
+			t.Errorf("The watchers were called as %v", calls)
+			break
+		}
+	}
+}
+
+func TestZeroAtom(t *testing.T) {
+	var a Atom
+	if a.Load() != nil {
+		t.Errorf("The zero atom references %v", a.Load())
+	}
+
+	if !a.CompareAndSwap(nil, L(1)) {
+		t.Error("CompareAndSwap failed on the zero atom")
+	}
+
+	var zero Atom
+	notified := 0
+	zero.Watch(func(old, updated *List) {
+		notified++
+	})
+
+	zero.Swap(func(l *List) *List {
+		if l == nil {
+			return L(1)
+		}
+		return Cons(0, l)
+	})
+
+	if !Equal(zero.Load(), L(1)) || notified != 1 {
+		t.Errorf("Swap gave %v after notifying %d times", zero.Load(), notified)
+	}
+}
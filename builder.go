@@ -0,0 +1,90 @@
+package lst
+
+import (
+	"errors"
+)
+
+// ErrFrozen is returned when a Builder is used after being frozen.
+var ErrFrozen = errors.New("lst: builder already frozen")
+
+// A Builder builds a list by adding elements to both of its ends. Unlike
+// Cons, it owns its vector, so it can modify it in place, and adding an
+// element takes amortized constant time, without creating a new list.
+// Freezing gives the list built, after which the builder can't be used
+// anymore. The zero value is an empty builder ready to use.
+//
+// Example:
+//
+// var b Builder
+// b.Append(2)
+// b.Append(3)
+// b.Prepend(1)
+// l, err := b.Freeze()
+// -> l = [1, 2, 3]
+type Builder struct {
+	// The elements are buf[lo:hi], in the reverse order, like in lists
+	buf    []Elem
+	lo, hi int
+	frozen bool
+}
+
+// Len gives the number of elements added so far.
+func (b *Builder) Len() int {
+	return b.hi - b.lo
+}
+
+// Prepend inserts an element in the front of the list being built.
+func (b *Builder) Prepend(x Elem) error {
+	if b.frozen {
+		return ErrFrozen
+	}
+
+	if b.hi == len(b.buf) {
+		b.grow(0, b.Len()+1)
+	}
+	b.buf[b.hi] = x
+	b.hi++
+	return nil
+}
+
+// Append inserts an element at the end of the list being built.
+func (b *Builder) Append(x Elem) error {
+	if b.frozen {
+		return ErrFrozen
+	}
+
+	if b.lo == 0 {
+		b.grow(b.Len()+1, 0)
+	}
+	b.lo--
+	b.buf[b.lo] = x
+	return nil
+}
+
+// grow reallocates the vector, leaving at least the given number of free
+// slots before and after the elements
+func (b *Builder) grow(before, after int) {
+	before = max(before, b.lo)
+	after = max(after, len(b.buf)-b.hi)
+
+	buf := make([]Elem, before+b.Len()+after)
+	copy(buf[before:], b.buf[b.lo:b.hi])
+	b.buf, b.lo, b.hi = buf, before, before+b.Len()
+}
+
+// Freeze gives the list built, in constant time, since it takes the vector of
+// the builder. Its free slots are used by Cons afterwards.
+func (b *Builder) Freeze() (*List, error) {
+	if b.frozen {
+		return nil, ErrFrozen
+	}
+	b.frozen = true
+
+	l := &List{
+		elements:   b.buf[b.lo:b.hi],
+		firstEmpty: newCounter(b.hi),
+		firstUsed:  b.lo,
+	}
+	b.buf = nil
+	return l, nil
+}
@@ -0,0 +1,91 @@
+package lst
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBuilder(t *testing.T) {
+	var b Builder
+	for i := 0; i < N; i++ {
+		b.Append(i)
+		b.Prepend(-i - 1)
+	}
+
+	if b.Len() != 2*N {
+		t.Errorf("The builder has %d elements instead of %d", b.Len(), 2*N)
+	}
+
+	l, err := b.Freeze()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range Enumerate(l) {
+		if x != i-N {
+			t.Errorf("Found %v at index %d", x, i)
+			break
+		}
+	}
+
+	// The free slots of the vector are shared by Cons
+	c := Cons(nil, l)
+	if c.firstEmpty != l.firstEmpty || Len(c) != 2*N+1 {
+		t.Errorf("Cons didn't share the vector of the frozen list")
+	}
+}
+
+func TestEmptyBuilder(t *testing.T) {
+	var b Builder
+	l, err := b.Freeze()
+	if err != nil || !Empty(l) {
+		t.Errorf("Freezing an empty builder gave %v, %v", l, err)
+	}
+
+	if c := Cons(1, l); !Equal(c, L(1)) {
+		t.Errorf("Cons gave %v", c)
+	}
+}
+
+func TestFrozenBuilder(t *testing.T) {
+	var b Builder
+	b.Append(1)
+	l, _ := b.Freeze()
+
+	if err := b.Append(2); !errors.Is(err, ErrFrozen) {
+		t.Errorf("Append gave the error %v", err)
+	}
+
+	if err := b.Prepend(0); !errors.Is(err, ErrFrozen) {
+		t.Errorf("Prepend gave the error %v", err)
+	}
+
+	if _, err := b.Freeze(); !errors.Is(err, ErrFrozen) {
+		t.Errorf("Freeze gave the error %v", err)
+	}
+
+	if !Equal(l, L(1)) {
+		t.Errorf("Using a frozen builder changed its list: %v", l)
+	}
+}
+
+func BenchmarkBuilder(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var builder Builder
+		for j := 0; j < longLength; j++ {
+			builder.Append(j)
+		}
+		builder.Freeze()
+	}
+}
+
+func BenchmarkConsLoop(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		l := New()
+		for j := longLength - 1; j >= 0; j-- {
+			l = Cons(j, l)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package lst
+
+/*
+ * Versions of the functions comparing elements which take the comparison as an
+ * argument. The ones ending in "By" receive an equality function, and, since
+ * elements can't be hashed that way, may take quadratic time. The ones ending
+ * in "On" receive a function extracting a key from each element, and compare
+ * the keys as the package compares elements, hashing them whenever possible.
+ */
+
+func identity(x Elem) Elem {
+	return x
+}
+
+// ElementBy tells if some element of the list is equal to x according to the
+// function eq.
+func ElementBy(x Elem, l *List, eq func(x, y Elem) bool) bool {
+	_, found := ElemIndexBy(x, l, eq)
+	return found
+}
+
+// ElemIndexBy is like ElemIndex, but uses the function eq to compare the
+// elements.
+func ElemIndexBy(x Elem, l *List, eq func(x, y Elem) bool) (int, bool) {
+	for i := 0; i < Len(l); i++ {
+		if eq(x, Get(l, i)) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// GroupBy groups consecutive elements into sublists, putting an element in the
+// same group as the first element of the group if the function eq says they
+// are equal. The sublists share the vector of the original list.
+//
+// Example:
+//
+// l := L(1, 2, 3, 10, 11, 20)
+// GroupBy(l, func(x, y Elem) bool {
+// 	return y.(int)-x.(int) < 3
+// })
+// -> [[1, 2, 3], [10, 11], [20]]
+func GroupBy(l *List, eq func(x, y Elem) bool) *List {
+	var groups []Elem
+	start := 0
+	for i := 1; i < Len(l); i++ {
+		if !eq(Get(l, start), Get(l, i)) {
+			groups = append(groups, Slice(l, start, i))
+			start = i
+		}
+	}
+
+	if !Empty(l) {
+		groups = append(groups, Slice(l, start, Len(l)))
+	}
+	return fromSlice(groups)
+}
+
+// GroupOn groups consecutive elements having equal keys into sublists.
+//
+// Example:
+//
+// l := L("apple", "avocado", "banana", "cherry", "coconut")
+// GroupOn(l, func(x Elem) Elem {
+// 	return x.(string)[0]
+// })
+// -> [[apple, avocado], [banana], [cherry, coconut]]
+func GroupOn(l *List, key func(Elem) Elem) *List {
+	return GroupBy(l, func(x, y Elem) bool {
+		return equal(key(x), key(y))
+	})
+}
+
+// UniqueBy is like Unique, but uses the function eq to compare the elements.
+// It takes quadratic time.
+func UniqueBy(l *List, eq func(x, y Elem) bool) *List {
+	var uniq []Elem
+	for x := range Values(l) {
+		repeated := false
+		for _, y := range uniq {
+			if eq(y, x) {
+				repeated = true
+				break
+			}
+		}
+
+		if !repeated {
+			uniq = append(uniq, x)
+		}
+	}
+	return fromSlice(uniq)
+}
+
+// Synonym for UniqueBy
+var NubBy = UniqueBy
+
+// UniqueOn keeps only the first element of the list having each key.
+//
+// Example:
+//
+// l := L("apple", "avocado", "banana", "cherry", "coconut")
+// UniqueOn(l, func(x Elem) Elem {
+// 	return x.(string)[0]
+// })
+// -> [apple, banana, cherry]
+func UniqueOn(l *List, key func(Elem) Elem) *List {
+	keys := newElemSet()
+	var uniq []Elem
+	for x := range Values(l) {
+		if keys.add(key(x)) {
+			uniq = append(uniq, x)
+		}
+	}
+	return fromSlice(uniq)
+}
+
+// DeleteBy deletes the first element of the list equal to x according to the
+// function eq. If there's no such element, the list is returned unchanged.
+func DeleteBy(x Elem, l *List, eq func(x, y Elem) bool) *List {
+	i, found := ElemIndexBy(x, l, eq)
+	if !found {
+		return l
+	}
+	return DeleteAt(l, i)
+}
+
+// DeleteOn deletes the first element of the list having the same key as x. If
+// there's no such element, the list is returned unchanged.
+func DeleteOn(x Elem, l *List, key func(Elem) Elem) *List {
+	return DeleteBy(x, l, func(x, y Elem) bool {
+		return equal(key(x), key(y))
+	})
+}
+
+// DifferenceBy is like Difference, but uses the function eq to compare the
+// elements. It takes quadratic time.
+func DifferenceBy(base, subtract *List, eq func(x, y Elem) bool) *List {
+	return Filter(base, func(x Elem) bool {
+		return !ElementBy(x, subtract, eq)
+	})
+}
+
+// DifferenceOn removes, from the first list, the elements having the same key
+// as some element of the second one.
+func DifferenceOn(base, subtract *List, key func(Elem) Elem) *List {
+	keys := newElemSet()
+	for x := range Values(subtract) {
+		keys.add(key(x))
+	}
+	return Filter(base, func(x Elem) bool {
+		return !keys.contains(key(x))
+	})
+}
+
+// UnionBy is like Union, but uses the function eq to compare the elements. It
+// takes quadratic time.
+func UnionBy(l1, l2 *List, eq func(x, y Elem) bool) *List {
+	return Concatenate(l1, DifferenceBy(UniqueBy(l2, eq), l1, eq))
+}
+
+// UnionOn is like Union, but compares the keys of the elements instead of the
+// elements themselves.
+func UnionOn(l1, l2 *List, key func(Elem) Elem) *List {
+	return Concatenate(l1, DifferenceOn(UniqueOn(l2, key), l1, key))
+}
+
+// IntersectBy is like Intersect, but uses the function eq to compare the
+// elements. It takes quadratic time.
+func IntersectBy(l1, l2 *List, eq func(x, y Elem) bool) *List {
+	return Filter(l1, func(x Elem) bool {
+		return ElementBy(x, l2, eq)
+	})
+}
+
+// IntersectOn keeps, from the first list, the elements having the same key as
+// some element of the second one.
+func IntersectOn(l1, l2 *List, key func(Elem) Elem) *List {
+	keys := newElemSet()
+	for x := range Values(l2) {
+		keys.add(key(x))
+	}
+	return Filter(l1, func(x Elem) bool {
+		return keys.contains(key(x))
+	})
+}
+
+// EqualBy is like Equal, but uses the function eq to compare the elements.
+func EqualBy(l1, l2 *List, eq func(x, y Elem) bool) bool {
+	if Len(l1) != Len(l2) {
+		return false
+	}
+
+	for i := 0; i < Len(l1); i++ {
+		if !eq(Get(l1, i), Get(l2, i)) {
+			return false
+		}
+	}
+	return true
+}
+
+// MaximumBy gives the greatest element of a non-empty list, according to the
+// function less.
+func MaximumBy(l *List, less func(x, y Elem) bool) Elem {
+	return Foldl1(l, func(acc interface{}, x Elem) interface{} {
+		if less(acc, x) {
+			return x
+		}
+		return acc
+	})
+}
+
+// MinimumBy gives the least element of a non-empty list, according to the
+// function less.
+func MinimumBy(l *List, less func(x, y Elem) bool) Elem {
+	return Foldl1(l, func(acc interface{}, x Elem) interface{} {
+		if less(x, acc) {
+			return x
+		}
+		return acc
+	})
+}
+
+// InsertBy inserts x in a list sorted according to the function less, just
+// before the first element greater than it, so that the new list remains
+// sorted.
+//
+// Example:
+//
+// InsertBy(4, L(1, 3, 5, 7), func(x, y Elem) bool {
+// 	return x.(int) < y.(int)
+// })
+// -> [1, 3, 4, 5, 7]
+func InsertBy(x Elem, l *List, less func(x, y Elem) bool) *List {
+	i := prefixLength(l, func(y Elem) bool {
+		return !less(x, y)
+	})
+	return InsertAt(l, i, x)
+}
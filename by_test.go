@@ -0,0 +1,140 @@
+package lst
+
+import (
+	"testing"
+)
+
+func firstLetter(x Elem) Elem {
+	return x.(string)[0]
+}
+
+func closeNumbers(x, y Elem) bool {
+	return y.(int)-x.(int) < 3
+}
+
+func sameParity(x, y Elem) bool {
+	return x.(int)%2 == y.(int)%2
+}
+
+func TestGroupBy(t *testing.T) {
+	l := L(1, 2, 3, 10, 11, 20)
+	groups := GroupBy(l, closeNumbers)
+
+	if !DeepEqual(groups, L(L(1, 2, 3), L(10, 11), L(20))) {
+		t.Errorf("GroupBy gave %v", groups)
+	}
+
+	if !Empty(GroupBy(New(), closeNumbers)) {
+		t.Error("GroupBy of an empty list isn't empty")
+	}
+
+	// The groups must not clobber each other
+	first := Head(groups).(*List)
+	if c := Cons(0, first); !Equal(c, L(0, 1, 2, 3)) || !Equal(l, L(1, 2, 3, 10, 11, 20)) {
+		t.Errorf("Cons on a group gave %v and changed the original list to %v", c, l)
+	}
+}
+
+func TestGroupOn(t *testing.T) {
+	l := L("apple", "avocado", "banana", "cherry", "coconut")
+	groups := GroupOn(l, firstLetter)
+
+	if !DeepEqual(groups, L(L("apple", "avocado"), L("banana"), L("cherry", "coconut"))) {
+		t.Errorf("GroupOn gave %v", groups)
+	}
+}
+
+func TestUniqueByAndOn(t *testing.T) {
+	if u := UniqueBy(L(1, 3, 2, 5, 4), sameParity); !Equal(u, L(1, 2)) {
+		t.Errorf("UniqueBy gave %v", u)
+	}
+
+	l := L("apple", "avocado", "banana", "cherry", "coconut")
+	if u := UniqueOn(l, firstLetter); !Equal(u, L("apple", "banana", "cherry")) {
+		t.Errorf("UniqueOn gave %v", u)
+	}
+}
+
+func TestDeleteByAndOn(t *testing.T) {
+	if d := DeleteBy(7, L(2, 4, 5, 7), sameParity); !Equal(d, L(2, 4, 7)) {
+		t.Errorf("DeleteBy gave %v", d)
+	}
+
+	l := L("apple", "banana")
+	if d := DeleteOn("blueberry", l, firstLetter); !Equal(d, L("apple")) {
+		t.Errorf("DeleteOn gave %v", d)
+	}
+
+	if d := DeleteOn("cherry", l, firstLetter); d != l {
+		t.Errorf("DeleteOn changed the list to %v", d)
+	}
+}
+
+func TestSetOperationsByAndOn(t *testing.T) {
+	base := L("apple", "banana", "cherry", "avocado")
+	other := L("blueberry", "apricot", "date", "dewberry")
+
+	if d := DifferenceOn(base, other, firstLetter); !Equal(d, L("cherry")) {
+		t.Errorf("DifferenceOn gave %v", d)
+	}
+
+	if i := IntersectOn(base, other, firstLetter); !Equal(i, L("apple", "banana", "avocado")) {
+		t.Errorf("IntersectOn gave %v", i)
+	}
+
+	if u := UnionOn(base, other, firstLetter); !Equal(u, L("apple", "banana", "cherry", "avocado", "date")) {
+		t.Errorf("UnionOn gave %v", u)
+	}
+
+	if d := DifferenceBy(L(1, 2, 3, 4), L(2), sameParity); !Equal(d, L(1, 3)) {
+		t.Errorf("DifferenceBy gave %v", d)
+	}
+
+	if i := IntersectBy(L(1, 2, 3, 4), L(2), sameParity); !Equal(i, L(2, 4)) {
+		t.Errorf("IntersectBy gave %v", i)
+	}
+
+	if u := UnionBy(L(1, 3), L(5, 2, 4), sameParity); !Equal(u, L(1, 3, 2)) {
+		t.Errorf("UnionBy gave %v", u)
+	}
+}
+
+func TestElementByAndEqualBy(t *testing.T) {
+	if !ElementBy(4, L(1, 3, 6), sameParity) || ElementBy(4, L(1, 3), sameParity) {
+		t.Error("ElementBy isn't using the given function")
+	}
+
+	if !EqualBy(L(1, 2), L(3, 4), sameParity) || EqualBy(L(1, 2), L(3, 5), sameParity) {
+		t.Error("EqualBy isn't using the given function")
+	}
+}
+
+func TestInsertBy(t *testing.T) {
+	lessInt := func(x, y Elem) bool {
+		return x.(int) < y.(int)
+	}
+
+	cases := []struct {
+		x        int
+		expected *List
+	}{
+		{4, L(1, 3, 4, 5, 7)},
+		{0, L(0, 1, 3, 5, 7)},
+		{9, L(1, 3, 5, 7, 9)},
+		{5, L(1, 3, 5, 5, 7)},
+	}
+
+	for _, c := range cases {
+		if i := InsertBy(c.x, L(1, 3, 5, 7), lessInt); !Equal(i, c.expected) {
+			t.Errorf("InsertBy(%d) gave %v", c.x, i)
+		}
+	}
+
+	if m := MaximumBy(L(1, 3, 2), lessInt); m != 3 {
+		t.Errorf("MaximumBy gave %v", m)
+	}
+
+	if m := MinimumBy(L(1, 3, 2), lessInt); m != 1 {
+		t.Errorf("MinimumBy gave %v", m)
+	}
+}
@@ -0,0 +1,87 @@
+package lst
+
+import (
+	"sync/atomic"
+)
+
+/*
+ * Consing to a list whose next slot is already taken, or whose vector is full,
+ * copies its elements to a new vector. How much bigger than the list the new
+ * vector is depends on the growth strategy of the package, and lists can be
+ * given room in advance with NewWithCapacity and Reserve, avoiding the copies
+ * altogether.
+ */
+
+// A Growth gives the capacity of the vector to be allocated when consing to a
+// list of the given length requires copying it. Capacities smaller than
+// length+1 are ignored.
+type Growth func(length int) int
+
+// Doubling is the default growth strategy: the new vector has twice the length
+// of the list.
+func Doubling(length int) int {
+	return max(2*length, 4)
+}
+
+// Linear gives a growth strategy making room for “step” more elements each
+// time.
+func Linear(step int) Growth {
+	return func(length int) int {
+		return length + max(step, 1)
+	}
+}
+
+var growth atomic.Pointer[Growth]
+
+// SetGrowth changes the growth strategy used by the package. A nil one
+// restores the default, Doubling.
+//
+// Example:
+//
+// SetGrowth(Linear(1024))
+func SetGrowth(g Growth) {
+	growth.Store(&g)
+}
+
+// capacityFor gives the capacity of the vector to be allocated when consing to
+// a list of the given length
+func capacityFor(length int) int {
+	g := Doubling
+	if p := growth.Load(); p != nil && *p != nil {
+		g = *p
+	}
+	return max(g(length), length+1)
+}
+
+// NewWithCapacity creates an empty list whose vector has room for n elements,
+// so that the first n calls to Cons don't copy it.
+func NewWithCapacity(n int) *List {
+	l := new(List)
+	l.elements = make([]Elem, 0, max(n, 0))
+	l.firstEmpty = new(atomic.Int64)
+	return l
+}
+
+// Reserve gives a list with the same elements as l, whose vector has room for
+// n more elements in its front. It's l itself if its vector already has that
+// room, and nothing else has been consed to it; otherwise, its elements are
+// copied to a new vector. The room isn't guaranteed to stay free, though:
+// other lists sharing the vector may cons to it too.
+//
+// Example:
+//
+// l = Reserve(l, 1000000)
+// for i := 0; i < 1000000; i++ {
+// 	l = Cons(i, l)
+// }
+func Reserve(l *List, n int) *List {
+	length := Len(l)
+	free := cap(l.elements) - length
+	if free >= n && l.firstEmpty.Load() == int64(l.firstUsed+length) {
+		return l
+	}
+
+	elements := make([]Elem, length, length+max(n, 0))
+	copy(elements, l.elements)
+	return fromElements(elements)
+}
@@ -0,0 +1,72 @@
+package lst
+
+import (
+	"testing"
+)
+
+func TestNewWithCapacity(t *testing.T) {
+	l := NewWithCapacity(N)
+	counter := l.firstEmpty
+
+	for i := 0; i < N; i++ {
+		l = Cons(i, l)
+	}
+
+	if l.firstEmpty != counter {
+		t.Error("Consing to a list with enough capacity copied its vector")
+	}
+
+	if l = Cons(N, l); l.firstEmpty == counter || Len(l) != N+1 {
+		t.Error("Consing to a full vector should copy it")
+	}
+}
+
+func TestReserve(t *testing.T) {
+	l := L(1, 2, 3)
+	r := Reserve(l, N)
+
+	if !Equal(r, l) || r.firstEmpty == l.firstEmpty {
+		t.Fatalf("Reserve gave %v", r)
+	}
+
+	if Reserve(r, N) != r {
+		t.Error("Reserving room a list already has shouldn't copy it")
+	}
+
+	counter := r.firstEmpty
+	for i := 0; i < N; i++ {
+		r = Cons(i, r)
+	}
+
+	if r.firstEmpty != counter {
+		t.Error("Consing to a list with reserved room copied its vector")
+	}
+
+	// The slot in front of l is taken, so its room can't be used
+	base := Reserve(L(1), 10)
+	Cons(0, base)
+	if Reserve(base, 1) == base {
+		t.Error("Reserve gave a list whose next slot is taken")
+	}
+}
+
+func TestGrowth(t *testing.T) {
+	defer SetGrowth(nil)
+
+	full := L(1, 2, 3)
+	if c := Cons(0, full); cap(c.elements) != Doubling(3) {
+		t.Errorf("The default growth gave capacity %d", cap(c.elements))
+	}
+
+	SetGrowth(Linear(10))
+	if c := Cons(0, full); cap(c.elements) != 13 {
+		t.Errorf("Linear growth gave capacity %d", cap(c.elements))
+	}
+
+	SetGrowth(func(length int) int {
+		return 0
+	})
+	if c := Cons(0, full); cap(c.elements) != 4 || !Equal(c, L(0, 1, 2, 3)) {
+		t.Errorf("A too small capacity gave %v, with capacity %d", c, cap(c.elements))
+	}
+}
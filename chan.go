@@ -0,0 +1,165 @@
+package lst
+
+import (
+	"context"
+)
+
+/*
+ * Bridges between lists and channels, so that lists can be filled by producer
+ * goroutines and drained by consumer ones, and pipelines of goroutines can
+ * process their elements.
+ */
+
+// FromChan creates a list with the elements received from the channel, in the
+// order they were received, until it's closed. The channel may have any type
+// of elements.
+//
+// Example:
+//
+// jobs := make(chan Job)
+// go produce(jobs)
+// l := FromChan(jobs)
+func FromChan[T any](ch <-chan T) *List {
+	var elems []Elem
+	for x := range ch {
+		elems = append(elems, x)
+	}
+	return fromSlice(elems)
+}
+
+// Same as FromChan, but stops receiving when the context is done. In that
+// case, it gives the elements received so far and the error of the context.
+func FromChanCtx[T any](ctx context.Context, ch <-chan T) (*List, error) {
+	var elems []Elem
+	for {
+		select {
+		case x, ok := <-ch:
+			if !ok {
+				return fromSlice(elems), nil
+			}
+			elems = append(elems, x)
+
+		case <-ctx.Done():
+			return fromSlice(elems), ctx.Err()
+		}
+	}
+}
+
+// ToChan gives a channel through which the elements of the list are sent, from
+// head to last, by another goroutine. The channel is closed after the last
+// element. The goroutine only finishes after all elements are received, so
+// use ToChanCtx if you may stop receiving earlier.
+//
+// Example:
+//
+// for x := range ToChan(L(1, 2, 3)) {
+// 	fmt.Println(x)
+// }
+func ToChan(l *List) <-chan Elem {
+	return ToChanCtx(context.Background(), l)
+}
+
+// Same as ToChan, but stops sending, and closes the channel, when the context
+// is done.
+func ToChanCtx(ctx context.Context, l *List) <-chan Elem {
+	ch := make(chan Elem)
+	go func() {
+		defer close(ch)
+		for x := range Values(l) {
+			select {
+			case ch <- x:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// A Stage is a step of a pipeline. It starts a goroutine receiving elements
+// from the in channel and sending the results through the channel it gives,
+// which is closed when in is closed or when the context is done.
+type Stage func(ctx context.Context, in <-chan Elem) <-chan Elem
+
+// MapStage creates a stage sending the result of applying f to each element.
+func MapStage(f func(Elem) Elem) Stage {
+	return stage(func(x Elem, out chan<- Elem, done <-chan struct{}) bool {
+		select {
+		case out <- f(x):
+			return true
+		case <-done:
+			return false
+		}
+	})
+}
+
+// FilterStage creates a stage sending only the elements satisfying the
+// predicate.
+func FilterStage(f func(Elem) bool) Stage {
+	return stage(func(x Elem, out chan<- Elem, done <-chan struct{}) bool {
+		if !f(x) {
+			return true
+		}
+
+		select {
+		case out <- x:
+			return true
+		case <-done:
+			return false
+		}
+	})
+}
+
+// stage creates a stage calling step for each element received, until the
+// input is closed or step returns false
+func stage(step func(x Elem, out chan<- Elem, done <-chan struct{}) bool) Stage {
+	return func(ctx context.Context, in <-chan Elem) <-chan Elem {
+		out := make(chan Elem)
+		go func() {
+			defer close(out)
+			for {
+				select {
+				case x, ok := <-in:
+					if !ok || !step(x, out, ctx.Done()) {
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return out
+	}
+}
+
+// Chain creates a stage passing the elements through the given stages, in
+// order.
+func Chain(stages ...Stage) Stage {
+	return func(ctx context.Context, in <-chan Elem) <-chan Elem {
+		for _, s := range stages {
+			in = s(ctx, in)
+		}
+		return in
+	}
+}
+
+// Pipeline passes the elements of the list through the given stages, each one
+// running in its own goroutine, and collects the results in a new list. If the
+// context is done before the end, it gives the results collected so far and
+// the error of the context.
+//
+// Example:
+//
+// l, err := Pipeline(ctx, L("1", "2", "x"),
+// 	MapStage(parse),
+// 	FilterStage(valid),
+// )
+func Pipeline(ctx context.Context, l *List, stages ...Stage) (*List, error) {
+	results, err := FromChanCtx(ctx, Chain(stages...)(ctx, ToChanCtx(ctx, l)))
+	if err == nil {
+		// The stages close their channels when the context is done, so the
+		// results may be incomplete even though the last channel was closed
+		err = ctx.Err()
+	}
+	return results, err
+}
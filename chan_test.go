@@ -0,0 +1,113 @@
+package lst
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan Elem)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			ch <- i
+		}
+		close(ch)
+	}()
+
+	if l := FromChan(ch); !Equal(l, L(1, 2, 3)) {
+		t.Errorf("FromChan gave %v", l)
+	}
+}
+
+func TestFromTypedChan(t *testing.T) {
+	ch := make(chan int, 3)
+	ch <- 1
+	ch <- 2
+	close(ch)
+
+	if l := FromChan(ch); !Equal(l, L(1, 2)) {
+		t.Errorf("FromChan gave %v", l)
+	}
+
+	strings := make(chan string, 1)
+	strings <- "a"
+	close(strings)
+
+	if l, err := FromChanCtx(context.Background(), strings); err != nil || !Equal(l, L("a")) {
+		t.Errorf("FromChanCtx gave %v, %v", l, err)
+	}
+}
+
+func TestFromChanCtx(t *testing.T) {
+	ch := make(chan Elem, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+
+	l, err := FromChanCtx(context.Background(), ch)
+	if err != nil || !Equal(l, L(1, 2)) {
+		t.Errorf("FromChanCtx gave %v, %v", l, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Nothing is ever sent through this channel
+	l, err = FromChanCtx(ctx, make(chan Elem))
+	if !errors.Is(err, context.Canceled) || !Empty(l) {
+		t.Errorf("FromChanCtx gave %v, %v", l, err)
+	}
+}
+
+func TestToChan(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	if received := FromChan(ToChan(l)); !Equal(received, l) {
+		t.Errorf("ToChan sent %v", received)
+	}
+}
+
+func TestToChanCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := ToChanCtx(ctx, NewFromSlice(elements[:]))
+
+	if x := <-ch; x != elements[0] {
+		t.Errorf("ToChanCtx sent %v first", x)
+	}
+	cancel()
+
+	// The channel must be closed after cancelling
+	received := 0
+	for range ch {
+		received++
+	}
+
+	if received > 1 {
+		t.Errorf("ToChanCtx sent %d elements after being cancelled", received)
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	double := MapStage(func(x Elem) Elem {
+		return 2 * x.(int)
+	})
+	big := FilterStage(func(x Elem) bool {
+		return x.(int) > 4
+	})
+
+	l, err := Pipeline(context.Background(), L(1, 2, 3, 4), double, big)
+	if err != nil || !Equal(l, L(6, 8)) {
+		t.Errorf("Pipeline gave %v, %v", l, err)
+	}
+
+	l, err = Pipeline(context.Background(), L(1, 2))
+	if err != nil || !Equal(l, L(1, 2)) {
+		t.Errorf("An empty pipeline gave %v, %v", l, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Pipeline(ctx, NewFromSlice(elements[:]), double); !errors.Is(err, context.Canceled) {
+		t.Errorf("A cancelled pipeline gave the error %v", err)
+	}
+}
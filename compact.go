@@ -0,0 +1,76 @@
+package lst
+
+import (
+	"sync/atomic"
+)
+
+/*
+ * Lists given by Tail, Init, Take, Drop, SplitAt and Slice share the vector of
+ * the original list, which keeps the whole vector, and every element in it,
+ * from being garbage collected. Compacting a list copies its elements to a
+ * vector of its own, so that the old one can be released.
+ */
+
+// Usage tells how many slots of its vector the list uses, and how many slots
+// the vector has.
+//
+// Example:
+//
+// used, total := Usage(Tail(L(1, 2, 3, 4)))
+// -> used = 3
+//    total = 4
+func Usage(l *List) (used, total int) {
+	return Len(l), l.firstUsed + cap(l.elements)
+}
+
+// Compact gives a list with the same elements as l in a vector of its own,
+// with no free slots, if l uses less than half of its vector. Otherwise, it's
+// not worth copying the elements, and l itself is given.
+func Compact(l *List) *List {
+	used, total := Usage(l)
+	if 2*used >= total {
+		return l
+	}
+	return compact(l)
+}
+
+func compact(l *List) *List {
+	elements := make([]Elem, Len(l))
+	copy(elements, l.elements)
+	return fromElements(elements)
+}
+
+type autoCompact struct {
+	minUsage  float64
+	minVector int
+}
+
+var autoCompaction atomic.Pointer[autoCompact]
+
+// SetAutoCompact makes Tail, Init, Take, Drop, SplitAt and Slice compact the
+// lists they give whenever they use less than the fraction minUsage of a
+// vector with at least minVector slots. Those functions then take linear time
+// when they compact a list. A zero minUsage disables it, which is the default.
+//
+// Example:
+//
+// // Copies lists using less than 10% of vectors with a million slots or more
+// SetAutoCompact(0.1, 1000000)
+func SetAutoCompact(minUsage float64, minVector int) {
+	autoCompaction.Store(&autoCompact{minUsage, minVector})
+}
+
+// autoCompacted compacts the list if the automatic compaction is enabled and
+// the list satisfies its conditions
+func autoCompacted(l *List) *List {
+	a := autoCompaction.Load()
+	if a == nil || a.minUsage <= 0 {
+		return l
+	}
+
+	used, total := Usage(l)
+	if total < a.minVector || float64(used) >= a.minUsage*float64(total) {
+		return l
+	}
+	return compact(l)
+}
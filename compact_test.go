@@ -0,0 +1,72 @@
+package lst
+
+import (
+	"testing"
+)
+
+func TestUsage(t *testing.T) {
+	l := NewFromSlice(elements[:])
+
+	if used, total := Usage(l); used != N || total != N {
+		t.Errorf("Usage gave %d and %d", used, total)
+	}
+
+	if used, total := Usage(Drop(l, N-3)); used != 3 || total != N {
+		t.Errorf("Usage gave %d and %d", used, total)
+	}
+
+	if used, total := Usage(Init(Init(l))); used != N-2 || total != N {
+		t.Errorf("Usage gave %d and %d", used, total)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	l := NewFromSlice(elements[:])
+
+	if Compact(Tail(l)).firstEmpty != l.firstEmpty {
+		t.Error("Compacting a list using most of its vector shouldn't copy it")
+	}
+
+	small := Slice(l, 10, 13)
+	c := Compact(small)
+	if !Equal(c, small) || c.firstEmpty == l.firstEmpty {
+		t.Fatalf("Compact gave %v", c)
+	}
+
+	if used, total := Usage(c); used != 3 || total != 3 {
+		t.Errorf("Usage of the compacted list gave %d and %d", used, total)
+	}
+
+	if e := Compact(Drop(l, N)); !Empty(e) {
+		t.Errorf("Compacting an empty list gave %v", e)
+	}
+}
+
+func TestAutoCompact(t *testing.T) {
+	defer SetAutoCompact(0, 0)
+	l := NewFromSlice(elements[:])
+
+	SetAutoCompact(0.5, N+1)
+	if Take(l, 3).firstEmpty != l.firstEmpty {
+		t.Error("Vectors smaller than the minimum shouldn't be compacted")
+	}
+
+	SetAutoCompact(0.5, N)
+	if Tail(l).firstEmpty != l.firstEmpty {
+		t.Error("Lists using most of their vector shouldn't be compacted")
+	}
+
+	first, rest := SplitAt(l, 3)
+	if first.firstEmpty == l.firstEmpty || !Equal(first, L(elements[:3]...)) {
+		t.Errorf("SplitAt should have compacted %v", first)
+	}
+
+	if rest.firstEmpty != l.firstEmpty || Len(rest) != N-3 {
+		t.Errorf("SplitAt shouldn't have compacted %v", rest)
+	}
+
+	SetAutoCompact(0, 0)
+	if Take(l, 3).firstEmpty != l.firstEmpty {
+		t.Error("Disabling automatic compaction didn't work")
+	}
+}
@@ -0,0 +1,61 @@
+package lst
+
+// DeepEqual returns true if the two lists have equal elements, comparing the
+// elements of nested lists instead of their addresses. It's the same as Equal,
+// which compares nested lists the same way.
+//
+// Example:
+//
+// DeepEqual(L(L(1, 2)), L(L(1, 2)))
+// -> true
+func DeepEqual(l1, l2 *List) bool {
+	return EqualBy(l1, l2, equal)
+}
+
+// Compare orders two lists lexicographically, using the function cmp to
+// compare their elements. Like cmp, it returns a negative number if the first
+// list comes before the second one, a positive number if it comes after, or
+// zero if they are equal.
+//
+// Example:
+//
+// Compare(L(1, 2, 3), L(1, 3), CompareElems)
+// -> -1
+//
+// Compare(L(1, 2), L(1, 2, 3), CompareElems)
+// -> -1
+func Compare(l1, l2 *List, cmp func(x, y Elem) int) int {
+	for i := 0; i < min(Len(l1), Len(l2)); i++ {
+		if c := cmp(Get(l1, i), Get(l2, i)); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case Len(l1) < Len(l2):
+		return -1
+	case Len(l1) > Len(l2):
+		return 1
+	}
+	return 0
+}
+
+// CompareElems compares two elements, returning -1, 0 or 1. Nested lists are
+// compared lexicographically; other elements must be numbers, strings or
+// implement Lesser.
+//
+// Example:
+//
+// SortBy(L(L(2), L(1, 5), L(1)), func(x, y Elem) bool {
+// 	return CompareElems(x, y) < 0
+// })
+// -> [[1], [1, 5], [2]]
+func CompareElems(x, y Elem) int {
+	switch {
+	case less(x, y):
+		return -1
+	case less(y, x):
+		return 1
+	}
+	return 0
+}
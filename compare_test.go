@@ -0,0 +1,85 @@
+package lst
+
+import (
+	"testing"
+)
+
+func TestDeepEqual(t *testing.T) {
+	l1 := L(1, L(2, L(3, 4)), "a")
+	l2 := L(1, L(2, L(3, 4)), "a")
+
+	if !Equal(l1, l2) {
+		t.Error("Equal comparing nested lists by their addresses")
+	}
+
+	if !DeepEqual(l1, l2) {
+		t.Error("DeepEqual saying equal nested lists differ")
+	}
+
+	if DeepEqual(l1, L(1, L(2, L(3, 5)), "a")) {
+		t.Error("DeepEqual saying different nested lists are equal")
+	}
+
+	if DeepEqual(L(L(1)), L(1)) {
+		t.Error("DeepEqual saying a list equals a number")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		l1, l2   *List
+		expected int
+	}{
+		{L(1, 2, 3), L(1, 3), -1},
+		{L(1, 2), L(1, 2, 3), -1},
+		{L(1, 2, 3), L(1, 2), 1},
+		{L("b"), L("a", "z"), 1},
+		{L(1, 2), L(1, 2), 0},
+		{New(), New(), 0},
+		{L(L(1), L(2)), L(L(1), L(1, 5)), 1},
+	}
+
+	for _, c := range cases {
+		if result := Compare(c.l1, c.l2, CompareElems); result != c.expected {
+			t.Errorf("Compare(%v, %v) returned %d instead of %d", c.l1, c.l2, result, c.expected)
+		}
+	}
+}
+
+func TestSortNestedLists(t *testing.T) {
+	l := L(L(2), L(1, 5), L(1), New())
+	sorted := SortBy(l, func(x, y Elem) bool {
+		return CompareElems(x, y) < 0
+	})
+
+	if !DeepEqual(sorted, L(New(), L(1), L(1, 5), L(2))) {
+		t.Errorf("Nested lists sorted as %v", sorted)
+	}
+
+	if m := MaximumBy(l, less); !DeepEqual(m.(*List), L(2)) {
+		t.Errorf("MaximumBy gave %v", m)
+	}
+}
+
+func TestListKeys(t *testing.T) {
+	tens := func(x Elem) Elem {
+		return L(x.(int) / 10)
+	}
+
+	if u := UniqueOn(L(1, 2, 3), tens); !Equal(u, L(1)) {
+		t.Errorf("UniqueOn gave %v", u)
+	}
+
+	l := L(1, 2, 11, 12, 21)
+	if g := GroupOn(l, tens); !Equal(g, L(L(1, 2), L(11, 12), L(21))) {
+		t.Errorf("GroupOn gave %v", g)
+	}
+
+	if d := DifferenceOn(l, L(15), tens); !Equal(d, L(1, 2, 21)) {
+		t.Errorf("DifferenceOn gave %v", d)
+	}
+
+	if i := IntersectOn(l, L(25, 3), tens); !Equal(i, L(1, 2, 21)) {
+		t.Errorf("IntersectOn gave %v", i)
+	}
+}
@@ -0,0 +1,121 @@
+package lst
+
+/*
+ * A deque is kept as two lists: the front one, holding the first elements,
+ * and the rear one, holding the last elements in the reverse order. Pushing
+ * and popping take place at the heads of these lists, which is cheap. To keep
+ * both ends accessible, whenever a list gets more than dequeBalance times
+ * longer than the other one, the elements are redistributed between them.
+ */
+
+const dequeBalance = 3
+
+// Deque is a persistent double-ended queue, to which elements can be added
+// and from which they can be removed at both ends in amortized constant time.
+type Deque struct {
+	front, rear *List
+}
+
+func NewDeque() *Deque {
+	return &Deque{New(), New()}
+}
+
+// DequeFromList creates a deque with the elements of a list, the head of the
+// list being the front of the deque.
+func DequeFromList(l *List) *Deque {
+	return balance(l, New())
+}
+
+// DequeToList creates a list with the elements of a deque, from front to back.
+func DequeToList(d *Deque) *List {
+	return Concatenate(d.front, Reverse(d.rear))
+}
+
+func DequeLen(d *Deque) int {
+	return Len(d.front) + Len(d.rear)
+}
+
+// Tells if a deque is empty
+func DequeEmpty(d *Deque) bool {
+	return DequeLen(d) == 0
+}
+
+// balance creates a deque redistributing the elements of front and rear if
+// one of them is too long
+func balance(front, rear *List) *Deque {
+	total := Len(front) + Len(rear)
+	half := total / 2
+
+	switch {
+	case Len(front) > dequeBalance*Len(rear)+1:
+		kept, moved := SplitAt(front, total-half)
+		rear = Concatenate(rear, Reverse(moved))
+		front = kept
+	case Len(rear) > dequeBalance*Len(front)+1:
+		kept, moved := SplitAt(rear, half)
+		front = Concatenate(front, Reverse(moved))
+		rear = kept
+	}
+
+	return &Deque{front, rear}
+}
+
+// Inserts an element at the front of the deque.
+func PushFront(d *Deque, x Elem) *Deque {
+	return balance(Cons(x, d.front), d.rear)
+}
+
+// Inserts an element at the back of the deque.
+func PushBack(d *Deque, x Elem) *Deque {
+	return balance(d.front, Cons(x, d.rear))
+}
+
+// PeekFront gives the element at the front of the deque. The second item it
+// returns is false if the deque is empty.
+func PeekFront(d *Deque) (Elem, bool) {
+	if Empty(d.front) {
+		// With a single element, it may be in the rear list
+		return HeadOK(d.rear)
+	}
+	return Head(d.front), true
+}
+
+// PeekBack gives the element at the back of the deque. The second item it
+// returns is false if the deque is empty.
+func PeekBack(d *Deque) (Elem, bool) {
+	if Empty(d.rear) {
+		return HeadOK(d.front)
+	}
+	return Head(d.rear), true
+}
+
+// PopFront removes the element at the front of the deque, returning it and the
+// remaining deque. The last item it returns is false if the deque is empty.
+//
+// Example:
+//
+// d := DequeFromList(L(1, 2, 3))
+// x, d, _ := PopFront(d)
+// -> x = 1
+//    DequeToList(d) = [2, 3]
+func PopFront(d *Deque) (Elem, *Deque, bool) {
+	switch {
+	case DequeEmpty(d):
+		return nil, d, false
+	case Empty(d.front):
+		return Head(d.rear), NewDeque(), true
+	}
+	return Head(d.front), balance(Tail(d.front), d.rear), true
+}
+
+// PopBack removes the element at the back of the deque, returning it and the
+// remaining deque. The last item it returns is false if the deque is empty.
+func PopBack(d *Deque) (Elem, *Deque, bool) {
+	switch {
+	case DequeEmpty(d):
+		return nil, d, false
+	case Empty(d.rear):
+		return Head(d.front), NewDeque(), true
+	}
+	return Head(d.rear), balance(d.front, Tail(d.rear)), true
+}
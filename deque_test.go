@@ -0,0 +1,80 @@
+package lst
+
+import (
+	"testing"
+)
+
+func TestDequeFromList(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	d := DequeFromList(l)
+
+	if DequeLen(d) != N {
+		t.Errorf("Deque has %d elements instead of %d", DequeLen(d), N)
+	}
+
+	if !Equal(DequeToList(d), l) {
+		t.Error("Converting back gave a different list")
+	}
+}
+
+func TestDequeQueue(t *testing.T) {
+	d := NewDeque()
+	for _, v := range elements {
+		d = PushBack(d, v)
+	}
+
+	for k, v := range elements {
+		x, rest, ok := PopFront(d)
+		if !ok || x != v {
+			t.Fatalf("Mismatched elements at index %d", k)
+		}
+		d = rest
+	}
+
+	if _, _, ok := PopFront(d); ok || !DequeEmpty(d) {
+		t.Error("Deque isn't empty after popping all its elements")
+	}
+}
+
+func TestDequeStack(t *testing.T) {
+	d := NewDeque()
+	for _, v := range elements {
+		d = PushFront(d, v)
+	}
+
+	for k, v := range elements {
+		x, rest, ok := PopFront(d)
+		if !ok || x != elements[N-k-1] {
+			t.Fatalf("Mismatched elements at index %d", k)
+		}
+		d = rest
+
+		d = PushBack(d, v)
+		if back, _ := PeekBack(d); back != v {
+			t.Fatalf("PeekBack returned %v instead of %v", back, v)
+		}
+	}
+
+	if !Equal(DequeToList(d), NewFromSlice(elements[:])) {
+		t.Error("Deque with the wrong elements")
+	}
+}
+
+func TestDequePersistence(t *testing.T) {
+	d := DequeFromList(L(1, 2, 3))
+	_, d2, _ := PopBack(d)
+	d3 := PushFront(d, 0)
+
+	switch {
+	case !Equal(DequeToList(d), L(1, 2, 3)):
+		t.Errorf("Original deque changed to %v", DequeToList(d))
+	case !Equal(DequeToList(d2), L(1, 2)):
+		t.Errorf("PopBack gave %v", DequeToList(d2))
+	case !Equal(DequeToList(d3), L(0, 1, 2, 3)):
+		t.Errorf("PushFront gave %v", DequeToList(d3))
+	}
+
+	if x, ok := PeekFront(DequeFromList(L(7))); !ok || x != 7 {
+		t.Errorf("PeekFront returned %v instead of 7", x)
+	}
+}
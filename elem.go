@@ -0,0 +1,225 @@
+package lst
+
+import (
+	"fmt"
+	"hash/maphash"
+	"math"
+	"reflect"
+)
+
+/*
+ * Interfaces elements can implement to tell the package how to compare, order,
+ * hash and show them. Elements not implementing them are compared with ==
+ * (or reflect.DeepEqual, when == would panic), ordered only if they are
+ * numbers, strings or lists, hashed by Go maps and shown with fmt. Nested
+ * lists are compared, ordered and hashed by their elements.
+ */
+
+// Equaler is implemented by elements knowing when they are equal to another
+// element. It's used by functions like Element, Group, Delete and Equal.
+type Equaler interface {
+	Equal(other Elem) bool
+}
+
+// Lesser is implemented by elements knowing when they are lesser than another
+// element.
+type Lesser interface {
+	Less(other Elem) bool
+}
+
+// Hasher is implemented by elements which can be hashed, allowing functions
+// like Unique, Difference and Intersect to run in linear time even when the
+// elements can't be used as keys of a Go map. Elements with equal hashes are
+// compared with Equal, so they must implement Equaler too.
+type Hasher interface {
+	Equaler
+	Hash() uint64
+}
+
+// Shower is implemented by elements knowing how to be shown inside the string
+// representation of a list.
+type Shower interface {
+	Show() string
+}
+
+// equal tells if two elements are equal. Nested lists are equal if their
+// elements are.
+func equal(x, y Elem) bool {
+	if e, ok := x.(Equaler); ok {
+		return e.Equal(y)
+	}
+
+	if xl, ok := x.(*List); ok {
+		yl, ok := y.(*List)
+		if !ok || xl == nil || yl == nil {
+			return ok && xl == yl
+		}
+		return DeepEqual(xl, yl)
+	}
+
+	if isComparable(x) {
+		return x == y
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// isComparable tells if the element can be compared with == without panicking
+func isComparable(x Elem) bool {
+	switch x.(type) {
+	case nil, bool, int, int64, float64, string:
+		return true
+	}
+	return reflect.ValueOf(x).Comparable()
+}
+
+// less tells if the element x is lesser than y. Nested lists are ordered
+// lexicographically. It panics if the elements can't be ordered.
+func less(x, y Elem) bool {
+	if l, ok := x.(Lesser); ok {
+		return l.Less(y)
+	}
+
+	switch x := x.(type) {
+	case int:
+		return x < y.(int)
+	case int8:
+		return x < y.(int8)
+	case int16:
+		return x < y.(int16)
+	case int32:
+		return x < y.(int32)
+	case int64:
+		return x < y.(int64)
+	case uint:
+		return x < y.(uint)
+	case uint8:
+		return x < y.(uint8)
+	case uint16:
+		return x < y.(uint16)
+	case uint32:
+		return x < y.(uint32)
+	case uint64:
+		return x < y.(uint64)
+	case float32:
+		return x < y.(float32)
+	case float64:
+		return x < y.(float64)
+	case string:
+		return x < y.(string)
+	case *List:
+		return Compare(x, y.(*List), CompareElems) < 0
+	}
+
+	panic(fmt.Sprintf("Elements of type %T can't be ordered", x))
+}
+
+var seed = maphash.MakeSeed()
+
+// hash gives a hash of the element consistent with equal: equal elements have
+// equal hashes. Elements the function can't hash, like the ones implementing
+// Equaler but not Hasher, all have the same hash.
+func hash(x Elem) uint64 {
+	switch x := x.(type) {
+	case Hasher:
+		return x.Hash()
+	case Equaler:
+		return 0
+	case *List:
+		if x == nil {
+			return 0
+		}
+
+		h := uint64(Len(x))
+		for y := range Values(x) {
+			h = 31*h + hash(y)
+		}
+		return h
+	case string:
+		return maphash.String(seed, x)
+	case int:
+		return uint64(x)
+	case int64:
+		return uint64(x)
+	case float64:
+		// 0 and -0 are equal, but have different bits
+		if x == 0 {
+			return 0
+		}
+		return math.Float64bits(x)
+	case bool:
+		if x {
+			return 1
+		}
+	}
+	return 0
+}
+
+// show gives the representation of an element inside a list
+func show(x Elem) string {
+	if s, ok := x.(Shower); ok {
+		return s.Show()
+	}
+	return fmt.Sprintf("%v", x)
+}
+
+// elemSet is a set of elements honouring the Equaler and Hasher interfaces.
+// Nested lists are hashed by their elements. Elements which are neither
+// hashable nor comparable are kept in a slice, so looking for them takes
+// linear time.
+type elemSet struct {
+	comparable map[Elem]bool
+	hashed     map[uint64][]Elem
+	others     []Elem
+}
+
+func newElemSet() *elemSet {
+	return &elemSet{
+		comparable: make(map[Elem]bool),
+		hashed:     make(map[uint64][]Elem),
+	}
+}
+
+// add inserts an element in the set, returning false if it was already there
+func (s *elemSet) add(x Elem) bool {
+	if s.contains(x) {
+		return false
+	}
+
+	switch x.(type) {
+	case Hasher, *List:
+		h := hash(x)
+		s.hashed[h] = append(s.hashed[h], x)
+	case Equaler:
+		s.others = append(s.others, x)
+	default:
+		if isComparable(x) {
+			s.comparable[x] = true
+		} else {
+			s.others = append(s.others, x)
+		}
+	}
+	return true
+}
+
+func (s *elemSet) contains(x Elem) bool {
+	var candidates []Elem
+
+	switch x.(type) {
+	case Hasher, *List:
+		candidates = s.hashed[hash(x)]
+	case Equaler:
+		candidates = s.others
+	default:
+		if isComparable(x) {
+			return s.comparable[x]
+		}
+		candidates = s.others
+	}
+
+	for _, y := range candidates {
+		if equal(x, y) {
+			return true
+		}
+	}
+	return false
+}
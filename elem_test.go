@@ -0,0 +1,170 @@
+package lst
+
+import (
+	"strings"
+	"testing"
+)
+
+// A case insensitive string, implementing all the interfaces
+type word string
+
+func (w word) Equal(other Elem) bool {
+	o, ok := other.(word)
+	return ok && strings.EqualFold(string(w), string(o))
+}
+
+func (w word) Less(other Elem) bool {
+	return strings.ToLower(string(w)) < strings.ToLower(string(other.(word)))
+}
+
+func (w word) Hash() uint64 {
+	var h uint64
+	for _, r := range strings.ToLower(string(w)) {
+		h = 31*h + uint64(r)
+	}
+	return h
+}
+
+func (w word) Show() string {
+	return strings.ToUpper(string(w))
+}
+
+// An element equal to others with the same id, but neither hashable nor
+// comparable
+type record struct {
+	id   int
+	tags []string
+}
+
+func (r record) Equal(other Elem) bool {
+	o, ok := other.(record)
+	return ok && r.id == o.id
+}
+
+func TestEqualer(t *testing.T) {
+	l := L(word("Go"), word("is"), word("fun"))
+
+	if !Element(word("GO"), l) {
+		t.Error("Element isn't using Equal")
+	}
+
+	if i, _ := ElemIndex(word("FUN"), l); i != 2 {
+		t.Errorf("ElemIndex found the element at index %d instead of 2", i)
+	}
+
+	if !Equal(l, L(word("go"), word("IS"), word("Fun"))) {
+		t.Error("Equal isn't using the Equal method of the elements")
+	}
+
+	if d := Delete(word("IS"), l); Len(d) != 2 {
+		t.Errorf("Delete gave %v", d)
+	}
+
+	groups := Group(L(word("a"), word("A"), word("b")))
+	if Len(groups) != 2 {
+		t.Errorf("Group gave %v", groups)
+	}
+}
+
+func TestUncomparableElements(t *testing.T) {
+	l := L([]int{1, 2}, []int{3}, []int{1, 2})
+
+	if !Element([]int{3}, l) {
+		t.Error("Didn't find a slice in the list")
+	}
+
+	if u := Unique(l); Len(u) != 2 {
+		t.Errorf("Unique gave %v", u)
+	}
+
+	if d := Difference(l, L([]int{1, 2})); Len(d) != 1 {
+		t.Errorf("Difference gave %v", d)
+	}
+
+	records := L(record{1, []string{"a"}}, record{2, nil}, record{1, nil})
+	if u := Unique(records); Len(u) != 2 {
+		t.Errorf("Unique gave %v", u)
+	}
+}
+
+func TestNestedLists(t *testing.T) {
+	l := L(L(1), L(1), L(2), L(L(3)), New())
+
+	if !Element(L(1), l) || !Element(L(L(3)), l) || Element(L(3), l) {
+		t.Error("Element isn't comparing nested lists by their elements")
+	}
+
+	if i, ok := ElemIndex(L(2), l); !ok || i != 2 {
+		t.Errorf("ElemIndex found the nested list at index %d", i)
+	}
+
+	if g := Group(l); !Equal(g, L(L(L(1), L(1)), L(L(2)), L(L(L(3))), L(New()))) {
+		t.Errorf("Group gave %v", g)
+	}
+
+	if d := Delete(L(1), l); !Equal(d, L(L(1), L(2), L(L(3)), New())) {
+		t.Errorf("Delete gave %v", d)
+	}
+
+	if !Equal(l, L(L(1), L(1), L(2), L(L(3)), L())) || Equal(l, L(L(1), L(1), L(2), L(L(4)), L())) {
+		t.Error("Equal isn't comparing nested lists by their elements")
+	}
+
+	if u := Unique(l); !Equal(u, L(L(1), L(2), L(L(3)), New())) {
+		t.Errorf("Unique gave %v", u)
+	}
+
+	if d := Difference(l, L(L(1), L(L(3)))); !Equal(d, L(L(2), New())) {
+		t.Errorf("Difference gave %v", d)
+	}
+
+	if i := Intersect(l, L(L(2), L(L(3)))); !Equal(i, L(L(2), L(L(3)))) {
+		t.Errorf("Intersect gave %v", i)
+	}
+
+	// Nested lists of elements which can't be hashed are still found
+	slices := L(L([]int{1}), L([]int{1}), L(word("a")), L(word("A")))
+	if u := Unique(slices); Len(u) != 2 {
+		t.Errorf("Unique gave %v", u)
+	}
+
+	var nilList *List
+	if !Element(nilList, L(1, nilList)) || Element(nilList, L(New())) {
+		t.Error("Element isn't handling nil lists")
+	}
+}
+
+func TestHasher(t *testing.T) {
+	l1 := L(word("Go"), word("GO"), word("is"), word("fun"))
+	l2 := L(word("FUN"), word("go"))
+
+	if u := Unique(l1); Len(u) != 3 {
+		t.Errorf("Unique gave %v", u)
+	}
+
+	if d := Difference(l1, l2); !Equal(d, L(word("is"))) {
+		t.Errorf("Difference gave %v", d)
+	}
+
+	if i := Intersect(l1, l2); Len(i) != 3 {
+		t.Errorf("Intersect gave %v", i)
+	}
+}
+
+func TestShower(t *testing.T) {
+	if s := L(word("go"), 1).String(); s != "[GO, 1]" {
+		t.Errorf("Wrong string representation: %s", s)
+	}
+}
+
+func TestLesser(t *testing.T) {
+	l := L(3, 1, 4, 1, 5)
+	if MaximumBy(l, less) != 5 || MinimumBy(l, less) != 1 {
+		t.Errorf("Got maximum %v and minimum %v", MaximumBy(l, less), MinimumBy(l, less))
+	}
+
+	words := L(word("b"), word("C"), word("a"))
+	if MaximumBy(words, less) != word("C") || MinimumBy(words, less) != word("a") {
+		t.Errorf("Got maximum %v and minimum %v", MaximumBy(words, less), MinimumBy(words, less))
+	}
+}
@@ -0,0 +1,117 @@
+package lst
+
+import (
+	"context"
+)
+
+/*
+ * Versions of the higher-order functions taking callbacks which can fail. The
+ * elements are visited from head to last, and the traversal stops at the first
+ * error, which is returned. The ones ending in "Ctx" also stop when the
+ * context is done, returning the error of the context.
+ */
+
+// Same as Map, but stops at the first error returned by f
+//
+// Example:
+//
+// MapE(L("1", "x", "3"), func(x Elem) (Elem, error) {
+// 	return strconv.Atoi(x.(string))
+// })
+// -> nil, strconv.Atoi: parsing "x": invalid syntax
+func MapE(l *List, f func(Elem) (Elem, error)) (*List, error) {
+	mapped := make([]Elem, Len(l))
+	last := Len(l) - 1
+	for i := 0; i < Len(l); i++ {
+		x, err := f(Get(l, i))
+		if err != nil {
+			return nil, err
+		}
+		mapped[last-i] = x
+	}
+	return fromElements(mapped), nil
+}
+
+// Same as Filter, but stops at the first error returned by f
+func FilterE(l *List, f func(Elem) (bool, error)) (*List, error) {
+	var filtered []Elem
+	for x := range Values(l) {
+		keep, err := f(x)
+		if err != nil {
+			return nil, err
+		}
+
+		if keep {
+			filtered = append(filtered, x)
+		}
+	}
+	return fromSlice(filtered), nil
+}
+
+// Same as Foldl, but stops at the first error returned by f
+func FoldlE(init interface{}, l *List, f func(acc interface{}, x Elem) (interface{}, error)) (interface{}, error) {
+	accum := init
+	for x := range Values(l) {
+		var err error
+		if accum, err = f(accum, x); err != nil {
+			return nil, err
+		}
+	}
+	return accum, nil
+}
+
+// Same as Each, but stops at the first error returned by f
+func EachE(l *List, f func(Elem) error) error {
+	for x := range Values(l) {
+		if err := f(x); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Same as MapE, but also stops when the context is done
+func MapCtx(ctx context.Context, l *List, f func(context.Context, Elem) (Elem, error)) (*List, error) {
+	return MapE(l, func(x Elem) (Elem, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return f(ctx, x)
+	})
+}
+
+// Same as FilterE, but also stops when the context is done
+func FilterCtx(ctx context.Context, l *List, f func(context.Context, Elem) (bool, error)) (*List, error) {
+	return FilterE(l, func(x Elem) (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		return f(ctx, x)
+	})
+}
+
+// Same as FoldlE, but also stops when the context is done
+func FoldlCtx(ctx context.Context, init interface{}, l *List, f func(ctx context.Context, acc interface{}, x Elem) (interface{}, error)) (interface{}, error) {
+	return FoldlE(init, l, func(acc interface{}, x Elem) (interface{}, error) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		return f(ctx, acc, x)
+	})
+}
+
+// Same as EachE, but also stops when the context is done
+//
+// Example:
+//
+// err := EachCtx(ctx, urls, func(ctx context.Context, x Elem) error {
+// 	return fetch(ctx, x.(string))
+// })
+func EachCtx(ctx context.Context, l *List, f func(context.Context, Elem) error) error {
+	return EachE(l, func(x Elem) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return f(ctx, x)
+	})
+}
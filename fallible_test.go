@@ -0,0 +1,130 @@
+package lst
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+var errOdd = errors.New("odd element")
+
+func failOnOdd(x Elem) error {
+	if x.(int)%2 != 0 {
+		return errOdd
+	}
+	return nil
+}
+
+func TestMapE(t *testing.T) {
+	atoi := func(x Elem) (Elem, error) {
+		return strconv.Atoi(x.(string))
+	}
+
+	l, err := MapE(L("1", "2", "3"), atoi)
+	if err != nil || !Equal(l, L(1, 2, 3)) {
+		t.Errorf("MapE gave %v, %v", l, err)
+	}
+
+	calls := 0
+	_, err = MapE(L("1", "x", "3"), func(x Elem) (Elem, error) {
+		calls++
+		return atoi(x)
+	})
+	if err == nil || calls != 2 {
+		t.Errorf("MapE should have stopped at the second element, but gave %v after %d calls", err, calls)
+	}
+}
+
+func TestFilterE(t *testing.T) {
+	even := func(x Elem) (bool, error) {
+		return x.(int)%2 == 0, nil
+	}
+
+	l, err := FilterE(L(1, 2, 3, 4), even)
+	if err != nil || !Equal(l, L(2, 4)) {
+		t.Errorf("FilterE gave %v, %v", l, err)
+	}
+
+	_, err = FilterE(L(2, 3), func(x Elem) (bool, error) {
+		return true, failOnOdd(x)
+	})
+	if !errors.Is(err, errOdd) {
+		t.Errorf("FilterE gave the error %v", err)
+	}
+}
+
+func TestFoldlE(t *testing.T) {
+	sum := func(acc interface{}, x Elem) (interface{}, error) {
+		return acc.(int) + x.(int), failOnOdd(x)
+	}
+
+	s, err := FoldlE(0, L(2, 4, 6), sum)
+	if err != nil || s != 12 {
+		t.Errorf("FoldlE gave %v, %v", s, err)
+	}
+
+	if _, err := FoldlE(0, L(2, 3, 4), sum); !errors.Is(err, errOdd) {
+		t.Errorf("FoldlE gave the error %v", err)
+	}
+}
+
+func TestEachE(t *testing.T) {
+	var visited []Elem
+	err := EachE(L(2, 4, 5, 6), func(x Elem) error {
+		visited = append(visited, x)
+		return failOnOdd(x)
+	})
+
+	if !errors.Is(err, errOdd) || len(visited) != 3 {
+		t.Errorf("EachE gave %v after visiting %v", err, visited)
+	}
+}
+
+func TestCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	l := L(1, 2, 3, 4)
+	visited := 0
+	cancelAtSecond := func(ctx context.Context, x Elem) error {
+		visited++
+		if x == 2 {
+			cancel()
+		}
+		return nil
+	}
+
+	if err := EachCtx(ctx, l, cancelAtSecond); !errors.Is(err, context.Canceled) || visited != 2 {
+		t.Errorf("EachCtx gave %v after visiting %d elements", err, visited)
+	}
+
+	// The context is already cancelled now
+	_, err := MapCtx(ctx, l, func(ctx context.Context, x Elem) (Elem, error) {
+		return x, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("MapCtx gave the error %v", err)
+	}
+
+	_, err = FilterCtx(ctx, l, func(ctx context.Context, x Elem) (bool, error) {
+		return true, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("FilterCtx gave the error %v", err)
+	}
+
+	_, err = FoldlCtx(ctx, 0, l, func(ctx context.Context, acc interface{}, x Elem) (interface{}, error) {
+		return acc, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("FoldlCtx gave the error %v", err)
+	}
+
+	m, err := MapCtx(context.Background(), l, func(ctx context.Context, x Elem) (Elem, error) {
+		return x.(int) * 10, nil
+	})
+	if err != nil || !Equal(m, L(10, 20, 30, 40)) {
+		t.Errorf("MapCtx gave %v, %v", m, err)
+	}
+}
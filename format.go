@@ -0,0 +1,128 @@
+package lst
+
+import (
+	"fmt"
+	"io"
+)
+
+/*
+ * Lists implement fmt.Formatter, so that printing them writes each element
+ * straight to the output, without building the whole string in memory.
+ */
+
+// Format writes the list according to the verb and flags given to the
+// functions of package fmt:
+//
+//	%v   the same as String: [1, 2, 3]
+//	%+v  adds the length of the list and the state of its vector: the index of
+//	     its first element, the slots already used and the capacity
+//	%#v  Go syntax: lst.L(1, 2, 3)
+//
+// Other verbs, like %d or %q, are applied to each element. A precision limits
+// the number of elements written from each list, the remaining ones being
+// replaced by an ellipsis: %.2v gives [1, 2, ...].
+func (l *List) Format(f fmt.State, verb rune) {
+	limit, ok := f.Precision()
+	if !ok {
+		limit = -1
+	}
+
+	writeFormatted(f, l, verb, elemFormat(f, verb), limit)
+
+	if l != nil && verb == 'v' && f.Flag('+') {
+		fmt.Fprintf(f, " (len:%d offset:%d used:%d cap:%d)",
+			Len(l), l.firstUsed, l.firstEmpty.Load(), l.firstUsed+cap(l.elements))
+	}
+}
+
+// elemFormat gives the format used for the elements, with the verb and flags
+// given to Format, but neither width nor precision
+func elemFormat(f fmt.State, verb rune) string {
+	format := []rune{'%'}
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			format = append(format, flag)
+		}
+	}
+	return string(append(format, verb))
+}
+
+func writeFormatted(f fmt.State, l *List, verb rune, format string, limit int) {
+	goSyntax := verb == 'v' && f.Flag('#')
+	if l == nil {
+		if goSyntax {
+			io.WriteString(f, "nil")
+		} else {
+			io.WriteString(f, "<nil>")
+		}
+		return
+	}
+
+	if goSyntax {
+		io.WriteString(f, "lst.L(")
+	} else {
+		io.WriteString(f, "[")
+	}
+
+	n := Len(l)
+	if limit >= 0 {
+		n = min(n, limit)
+	}
+
+	for i := 0; i < n; i++ {
+		if i > 0 {
+			io.WriteString(f, ", ")
+		}
+
+		switch x := Get(l, i).(type) {
+		case *List:
+			writeFormatted(f, x, verb, format, limit)
+		case Shower:
+			if !goSyntax && (verb == 'v' || verb == 's') {
+				io.WriteString(f, x.Show())
+			} else {
+				fmt.Fprintf(f, format, x)
+			}
+		case float32:
+			if goSyntax {
+				io.WriteString(f, "float32("+showFloat(float64(x), 32)+")")
+			} else {
+				fmt.Fprintf(f, format, x)
+			}
+		case float64:
+			if goSyntax {
+				io.WriteString(f, showFloat(x, 64))
+			} else {
+				fmt.Fprintf(f, format, x)
+			}
+		case int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr:
+			// Only int is the default type of an integer constant
+			if goSyntax {
+				fmt.Fprintf(f, "%T(%#v)", x, x)
+			} else {
+				fmt.Fprintf(f, format, x)
+			}
+		case nil:
+			if goSyntax {
+				io.WriteString(f, "nil")
+			} else {
+				fmt.Fprintf(f, format, x)
+			}
+		default:
+			fmt.Fprintf(f, format, x)
+		}
+	}
+
+	if n < Len(l) {
+		if n > 0 {
+			io.WriteString(f, ", ")
+		}
+		io.WriteString(f, "...")
+	}
+
+	if goSyntax {
+		io.WriteString(f, ")")
+	} else {
+		io.WriteString(f, "]")
+	}
+}
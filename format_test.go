@@ -0,0 +1,78 @@
+package lst
+
+import (
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	l := L(1, "a", L(2, nil), word("b"))
+
+	cases := []struct {
+		format   string
+		list     *List
+		expected string
+	}{
+		{"%v", l, "[1, a, [2, <nil>], B]"},
+		{"%s", L("a", "b"), "[a, b]"},
+		{"%#v", l, `lst.L(1, "a", lst.L(2, nil), "b")`},
+		{"%d", L(1, L(2)), "[1, [2]]"},
+		{"%q", L("a", 'b'), `["a", 'b']`},
+		{"%x", L(10, 255), "[a, ff]"},
+		{"%.2v", L(1, 2, 3), "[1, 2, ...]"},
+		{"%.0v", L(1, 2, 3), "[...]"},
+		{"%.5v", L(1, 2, 3), "[1, 2, 3]"},
+		{"%.1v", L(L(1, 2), 3), "[[1, ...], ...]"},
+		{"%#.1v", L(1, 2), "lst.L(1, ...)"},
+		{"%v", New(), "[]"},
+		{"%v", L(1, (*List)(nil)), "[1, <nil>]"},
+		{"%+v", L((*List)(nil)), "[<nil>] (len:1 offset:0 used:1 cap:1)"},
+		{"%#v", L(1, (*List)(nil)), "lst.L(1, nil)"},
+		{"%v", (*List)(nil), "<nil>"},
+		{"%#v", (*List)(nil), "nil"},
+		{"%#v", L(1.5, 2.0, float32(3)), "lst.L(1.5, 2.0, float32(3.0))"},
+		{"%#v", L(int32(65), uint8(3)), "lst.L(int32(65), uint8(0x3))"},
+		{"%#v", L(1, int8(-1), int64(2), uint(4), uintptr(5)), "lst.L(1, int8(-1), int64(2), uint(0x4), uintptr(0x5))"},
+		{"%v", L(1.5, 2.0), "[1.5, 2]"},
+		{"%+v", L(1, 2), "[1, 2] (len:2 offset:0 used:2 cap:2)"},
+		{"%+v", Tail(Cons(0, L(1, 2))), "[1, 2] (len:2 offset:0 used:3 cap:4)"},
+	}
+
+	for _, c := range cases {
+		if s := fmt.Sprintf(c.format, c.list); s != c.expected {
+			t.Errorf("Formatting with %s gave %s instead of %s", c.format, s, c.expected)
+		}
+	}
+
+	if s := L(1, (*List)(nil)).String(); s != "[1, <nil>]" {
+		t.Errorf("String gave %s for a list holding a nil list", s)
+	}
+
+	if s := fmt.Sprintf("%v", l); s != l.String() {
+		t.Errorf("%%v gave %s, but String gives %s", s, l.String())
+	}
+}
+
+func TestFormatTruncationAllocations(t *testing.T) {
+	l := New()
+	for i := 0; i < 100000; i++ {
+		l = Cons(i, l)
+	}
+
+	allocs := testing.AllocsPerRun(10, func() {
+		fmt.Fprintf(io.Discard, "%.10v", l)
+	})
+
+	if allocs > 20 {
+		t.Errorf("Formatting 10 elements made %v allocations", allocs)
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	l := NewFromSlice(elements[:])
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		fmt.Fprintf(io.Discard, "%v", l)
+	}
+}
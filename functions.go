@@ -103,13 +103,8 @@ func FloatProd(l *List) float64 {
 
 // Tells if some element belongs to the given list
 func Element(x Elem, l *List) bool {
-	switch {
-	case Empty(l):
-		return false
-	case x == Head(l):
-		return true
-	}
-	return Element(x, Tail(l))
+	_, found := ElemIndex(x, l)
+	return found
 }
 
 // Tells if some element does not belongs to the given list
@@ -122,34 +117,19 @@ func NotElement(x Elem, l *List) bool {
 // list. The second item it returns is true if the element could be found in 
 // the list, or false otherwise.
 func ElemIndex(x Elem, l *List) (int, bool) {
-	switch {
-	case Empty(l):
-		return -1, false
-	case x == Head(l):
-		return 0, true
-	}
-
-	i, ok := ElemIndex(x, Tail(l))
-	return i + 1, ok
+	return ElemIndexBy(x, l, equal)
 }
 
 // ElemIndices returns a list with the indices of all occurrences of the 
 // element x in the list xs
 func ElemIndices(x Elem, xs *List) *List {
-	count := -1
-	return indices(x, xs, count)
-}
-
-func indices(y Elem, ys *List, count int) *List {
-	if Empty(ys) {
-		return ys
-	}
-
-	count++
-	if Head(ys) == y {
-		return Cons(count, indices(y, Tail(ys), count))
+	var indices []Elem
+	for i := 0; i < Len(xs); i++ {
+		if equal(x, Get(xs, i)) {
+			indices = append(indices, i)
+		}
 	}
-	return indices(y, Tail(ys), count)
+	return fromSlice(indices)
 }
 
 // Zip merges two lists together, creating a new list where each element is 
@@ -164,10 +144,9 @@ func indices(y Elem, ys *List, count int) *List {
 //
 // -> zipped = [[1,5], [2,6], [3, 7]]
 func Zip(l1, l2 *List) *List {
-	if Empty(l1) || Empty(l2) {
-		return New()
-	}
-	return Cons(L(Head(l1), Head(l2)), Zip(Tail(l1), Tail(l2)))
+	return ZipWith(l1, l2, func(x, y Elem) Elem {
+		return L(x, y)
+	})
 }
 
 // ZipWith is simillar to Zip, but instead of automatically combining the 
@@ -183,10 +162,11 @@ func Zip(l1, l2 *List) *List {
 //
 // -> zipped = [5, 12, 21]
 func ZipWith(l1, l2 *List, f func(x, y Elem) Elem) *List {
-	if Empty(l1) || Empty(l2) {
-		return New()
+	zipped := make([]Elem, min(Len(l1), Len(l2)))
+	for i := range zipped {
+		zipped[i] = f(Get(l1, i), Get(l2, i))
 	}
-	return Cons(f(Head(l1), Head(l2)), ZipWith(Tail(l1), Tail(l2), f))
+	return fromSlice(zipped)
 }
 
 // TakeWhile creates a new list using the elements of the original one. It will 
@@ -201,15 +181,17 @@ func ZipWith(l1, l2 *List, f func(x, y Elem) Elem) *List {
 //
 // -> l2 = [1, 2, 3, 2]
 func TakeWhile(l *List, f func(x Elem) bool) *List {
-	if Empty(l) {
-		return New()
-	}
+	return Take(l, prefixLength(l, f))
+}
 
-	if f(Head(l)) {
-		return Cons(Head(l), TakeWhile(Tail(l), f))
+// prefixLength gives the length of the longest prefix of l whose elements
+// satisfy the predicate
+func prefixLength(l *List, f func(x Elem) bool) int {
+	i := 0
+	for i < Len(l) && f(Get(l, i)) {
+		i++
 	}
-
-	return New()
+	return i
 }
 
 // DropWhile, like TakeWhile, creates a new list using the elements of the 
@@ -225,15 +207,7 @@ func TakeWhile(l *List, f func(x Elem) bool) *List {
 //
 // -> l2 = [5, 4, 3, 9, 1]
 func DropWhile(l *List, f func(x Elem) bool) *List {
-	if Empty(l) {
-		return New()
-	}
-
-	if f(Head(l)) {
-		return DropWhile(Tail(l), f)
-	}
-
-	return l
+	return Drop(l, prefixLength(l, f))
 }
 
 // Span breaks the original list in two when it finds an element for which the 
@@ -251,8 +225,7 @@ func DropWhile(l *List, f func(x Elem) bool) *List {
 // -> l1 = [1, 2, 3, 2]
 //    l2 = [5, 4, 3, 9, 1]
 func Span(l *List, f func(x Elem) bool) (first, rest *List) {
-	// TODO This implementation is inefficient. Write a new one.
-	return TakeWhile(l, f), DropWhile(l, f)
+	return SplitAt(l, prefixLength(l, f))
 }
 
 // Flatten takes a list of lists and transforms it in a flat list.
@@ -264,7 +237,7 @@ func Span(l *List, f func(x Elem) bool) (first, rest *List) {
 // flat := Flatten(l)
 // -> flat = [1, 2, 3, 4]
 func Flatten(l *List) *List {
-	return Foldr1(l, func(x Elem, acc interface{}) interface{} {
+	return Foldr(New(), l, func(x Elem, acc interface{}) interface{} {
 		return Concatenate(x.(*List), acc.(*List))
 	}).(*List)
 }
@@ -272,7 +245,8 @@ func Flatten(l *List) *List {
 // Synonym for Flatten
 var Concat = Flatten
 
-// Takes a list of booleans and returns true only if all elements are true
+// Takes a list of booleans and returns true only if all elements are true (in
+// particular, it returns true for an empty list)
 //
 // Example:
 //
@@ -284,12 +258,13 @@ var Concat = Flatten
 // And(l2)
 // -> false
 func And(l *List) bool {
-	return Foldr1(l, func(x Elem, acc interface{}) interface{} {
+	return Foldr(true, l, func(x Elem, acc interface{}) interface{} {
 		return x.(bool) && acc.(bool)
 	}).(bool)
 }
 
-// Takes a list of booleans and returns true if there are true values in it.
+// Takes a list of booleans and returns true if there are true values in it
+// (so it returns false for an empty list).
 //
 // Example:
 //
@@ -299,7 +274,7 @@ func And(l *List) bool {
 // l2 := L(false, false, false, false)
 // -> false
 func Or(l *List) bool {
-	return Foldr1(l, func(x Elem, acc interface{}) interface{} {
+	return Foldr(false, l, func(x Elem, acc interface{}) interface{} {
 		return x.(bool) || acc.(bool)
 	}).(bool)
 }
@@ -353,23 +328,7 @@ func Any(l *List, f func(Elem) bool) bool {
 // Group(l)
 // -> [[1,1,1], [3,3], [2], [3,3], [6,6,6]]
 func Group(l *List) *List {
-	vec := [2]*List{New(), New()} // {final list, sublist}
-	result := Foldr(vec, l, func(x Elem, acc interface{}) interface{} {
-		v := acc.([2]*List)
-		switch {
-		case Empty(v[1]):
-			v[1] = Cons(x, v[1])
-		case x == Head(v[1]):
-			v[1] = Cons(x, v[1])
-		default:
-			v[0] = Cons(v[1], v[0])
-			v[1] = NewWithElements(x)
-		}
-		return v
-	})
-
-	r := result.([2]*List)
-	return Cons(r[1], r[0])
+	return GroupBy(l, equal)
 }
 
 // Returns two lists. The first one contains all the elements of the original 
@@ -408,28 +367,14 @@ func Partition(l *List, f func(Elem) bool) (satisfy, doNot *List) {
 // Unique(l)
 // -> [1, 3, 2, 6]
 func Unique(l *List) *List {
-	table := make(map[Elem]bool)
-	return unique(l, table)
-}
-
-func unique(l *List, table map[Elem]bool) *List {
-	if Empty(l) {
-		return l
-	}
-
-	head := Head(l)
-	if _, ok := table[head]; ok {
-		return unique(Tail(l), table)
-	}
-
-	table[head] = true
-	return Cons(head, unique(Tail(l), table))
+	return UniqueOn(l, identity)
 }
 
 // Synonym for Unique
 var Nub = Unique
 
-// Deletes the first occurrence of an element from a list.
+// Deletes the first occurrence of an element from a list. If the element isn't
+// found, the list is returned unchanged.
 //
 // Example:
 //
@@ -437,10 +382,7 @@ var Nub = Unique
 // Delete(3, l)
 // -> [1, 1, 1, 3, 2, 3, 3, 6, 6, 6]
 func Delete(x Elem, l *List) *List {
-	without, with := Span(l, func(y Elem) bool {
-		return x != y
-	})
-	return Concatenate(without, Tail(with))
+	return DeleteBy(x, l, equal)
 }
 
 // Removes, from the first list, the elements found in the second one.
@@ -452,17 +394,7 @@ func Delete(x Elem, l *List) *List {
 // Difference(l1, l2)
 // -> [1, 3, 4, 4, 6]
 func Difference(base, subtract *List) *List {
-	table := make(map[Elem]bool)
-	next := MakeIterator(subtract)
-	for e := next(); e != nil; e = next() {
-		table[e] = true
-	}
-	return Foldr(New(), base, func(x Elem, acc interface{}) interface{} {
-		if _, ok := table[x]; ok {
-			return acc
-		}
-		return Cons(x, acc.(*List))
-	}).(*List)
+	return DifferenceOn(base, subtract, identity)
 }
 
 // Makes the union of the two lists. Duplicated elements of the second list are 
@@ -476,7 +408,7 @@ func Difference(base, subtract *List) *List {
 // Union(l1, l2)
 // -> [1, 2, 2, 3, 4, 4, 5, 6, 7, 9, 7, 10]
 func Union(l1, l2 *List) *List {
-	return Concatenate(l1, Difference(Unique(l2), l1))
+	return UnionOn(l1, l2, identity)
 }
 
 // Makes the intersection of the two lists. If the first list contains 
@@ -488,33 +420,13 @@ func Union(l1, l2 *List) *List {
 // Intersect(l1, l2)
 // -> [2, 2, 5]
 func Intersect(l1, l2 *List) *List {
-	table := make(map[Elem]bool)
-	next := MakeIterator(l2)
-	for e := next(); e != nil; e = next() {
-		table[e] = true
-	}
-	return Foldr(New(), l1, func(x Elem, acc interface{}) interface{} {
-		if _, ok := table[x]; ok {
-			return Cons(x, acc.(*List))
-		}
-		return acc
-	}).(*List)
+	return IntersectOn(l1, l2, identity)
 }
 
-// Returns true if the two lists have equal elements.
+// Returns true if the two lists have equal elements. Nested lists are compared
+// by their elements.
 func Equal(l1, l2 *List) bool {
-	lenL1 := Len(l1)
-	lenL2 := Len(l2)
-	if lenL1 != lenL2 {
-		return false
-	}
-
-	for i := 0; i < lenL1; i++ {
-		if Get(l1, i) != Get(l2, i) {
-			return false
-		}
-	}
-	return true
+	return EqualBy(l1, l2, equal)
 }
 
 // Applies the function f to each element in the list, from left to right.
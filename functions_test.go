@@ -576,3 +576,99 @@ func TestIntersect(t *testing.T) {
 		t.Error("Wrong intersection")
 	}
 }
+
+// A list long enough to blow up the stack of recursive implementations
+const longLength = 1000000
+
+func longList() *List {
+	elems := make([]Elem, longLength)
+	for i := range elems {
+		elems[i] = i
+	}
+	return NewFromSlice(elems)
+}
+
+func TestLongList(t *testing.T) {
+	l := longList()
+
+	if !Element(longLength-1, l) {
+		t.Error("Didn't find the last element")
+	}
+
+	if i, _ := ElemIndex(longLength-1, l); i != longLength-1 {
+		t.Errorf("Last element found at index %d", i)
+	}
+
+	first, rest := Span(l, func(x Elem) bool {
+		return x.(int) < longLength/2
+	})
+	if Len(first) != longLength/2 || Len(rest) != longLength/2 {
+		t.Errorf("Span gave lists with %d and %d elements", Len(first), Len(rest))
+	}
+
+	if Len(Zip(l, l)) != longLength || Len(Unique(l)) != longLength {
+		t.Error("Zip or Unique with the wrong length")
+	}
+
+	if Len(Delete(longLength-1, l)) != longLength-1 {
+		t.Error("Delete didn't remove the last element")
+	}
+}
+
+func BenchmarkElement(b *testing.B) {
+	l := longList()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Element(longLength-1, l)
+	}
+}
+
+func BenchmarkElemIndices(b *testing.B) {
+	l := longList()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ElemIndices(longLength-1, l)
+	}
+}
+
+func BenchmarkZipWith(b *testing.B) {
+	l := longList()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ZipWith(l, l, func(x, y Elem) Elem {
+			return x
+		})
+	}
+}
+
+func BenchmarkSpan(b *testing.B) {
+	l := longList()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Span(l, func(x Elem) bool {
+			return x.(int) < longLength/2
+		})
+	}
+}
+
+func BenchmarkUnique(b *testing.B) {
+	l := longList()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Unique(l)
+	}
+}
+
+func BenchmarkDelete(b *testing.B) {
+	l := longList()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Delete(longLength/2, l)
+	}
+}
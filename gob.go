@@ -0,0 +1,173 @@
+package lst
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"io"
+	"sync/atomic"
+)
+
+/*
+ * Lists sharing a vector are encoded together with it, so that decoding gives
+ * lists sharing a new vector the same way, and consing to them behaves just
+ * as it would have done with the original ones. Elements are encoded with
+ * package gob, so their concrete types must be registered with gob.Register.
+ * Nested lists are encoded on their own, without sharing vectors with other
+ * lists.
+ */
+
+func init() {
+	gob.Register(new(List))
+}
+
+var errCorruptSnapshot = errors.New("lst: corrupt snapshot")
+
+// gobVector is the part of a vector used by the encoded lists
+type gobVector struct {
+	Elements []Elem
+	// Both relative to the start of Elements
+	FirstEmpty int
+	Cap        int
+}
+
+type gobList struct {
+	Vector    int // -1 for a nil list
+	FirstUsed int
+	Len       int
+}
+
+type gobSnapshot struct {
+	Vectors []gobVector
+	Lists   []gobList
+}
+
+// EncodeSnapshot writes several lists at once, writing each vector shared by
+// them just once.
+//
+// Example:
+//
+// l := L(1, 2, 3)
+// err := EncodeSnapshot(w, l, Tail(l), Cons(0, l))
+func EncodeSnapshot(w io.Writer, lists ...*List) error {
+	return gob.NewEncoder(w).Encode(snapshot(lists))
+}
+
+// DecodeSnapshot reads the lists written by EncodeSnapshot, in the same order.
+// The lists sharing a vector when they were written share a new one.
+func DecodeSnapshot(r io.Reader) ([]*List, error) {
+	var s gobSnapshot
+	if err := gob.NewDecoder(r).Decode(&s); err != nil {
+		return nil, err
+	}
+	return restore(s)
+}
+
+// GobEncode encodes the list, and the state of its vector, for package gob.
+func (l *List) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	err := EncodeSnapshot(&buf, l)
+	return buf.Bytes(), err
+}
+
+// GobDecode decodes a list encoded by GobEncode.
+func (l *List) GobDecode(data []byte) error {
+	lists, err := DecodeSnapshot(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	if len(lists) != 1 || lists[0] == nil {
+		return errCorruptSnapshot
+	}
+
+	*l = *lists[0]
+	return nil
+}
+
+func snapshot(lists []*List) (s gobSnapshot) {
+	// The firstEmpty counter identifies the vector shared by the lists
+	vectors := make(map[*atomic.Int64]int)
+	var bounds [][2]int
+
+	s.Lists = make([]gobList, len(lists))
+	for i, l := range lists {
+		if l == nil {
+			s.Lists[i].Vector = -1
+			continue
+		}
+
+		v, ok := vectors[l.firstEmpty]
+		if !ok {
+			v = len(bounds)
+			vectors[l.firstEmpty] = v
+			bounds = append(bounds, [2]int{l.firstUsed, l.firstUsed})
+		}
+
+		bounds[v][0] = min(bounds[v][0], l.firstUsed)
+		bounds[v][1] = max(bounds[v][1], l.firstUsed+Len(l))
+		s.Lists[i] = gobList{v, l.firstUsed, Len(l)}
+	}
+
+	s.Vectors = make([]gobVector, len(bounds))
+	for v := range s.Vectors {
+		s.Vectors[v].Elements = make([]Elem, bounds[v][1]-bounds[v][0])
+	}
+
+	for i, l := range lists {
+		if l == nil {
+			continue
+		}
+
+		v := s.Lists[i].Vector
+		start := bounds[v][0]
+		copy(s.Vectors[v].Elements[l.firstUsed-start:], l.elements)
+		s.Vectors[v].FirstEmpty = int(l.firstEmpty.Load()) - start
+		s.Vectors[v].Cap = l.firstUsed + cap(l.elements) - start
+		s.Lists[i].FirstUsed -= start
+	}
+	return
+}
+
+func restore(s gobSnapshot) ([]*List, error) {
+	type vector struct {
+		elements   []Elem
+		firstEmpty *atomic.Int64
+	}
+
+	vectors := make([]vector, len(s.Vectors))
+	for v, gv := range s.Vectors {
+		length := len(gv.Elements)
+		if gv.FirstEmpty < length || gv.Cap < gv.FirstEmpty {
+			return nil, errCorruptSnapshot
+		}
+
+		elements := make([]Elem, gv.FirstEmpty, gv.Cap)
+		copy(elements, gv.Elements)
+		vectors[v] = vector{elements, newCounter(gv.FirstEmpty)}
+	}
+
+	lists := make([]*List, len(s.Lists))
+	for i, gl := range s.Lists {
+		if gl.Vector == -1 {
+			continue
+		}
+
+		if gl.Vector < 0 || gl.Vector >= len(vectors) || gl.FirstUsed < 0 || gl.Len < 0 {
+			return nil, errCorruptSnapshot
+		}
+
+		v := vectors[gl.Vector]
+		end := gl.FirstUsed + gl.Len
+		if end > len(v.elements) {
+			return nil, errCorruptSnapshot
+		}
+
+		lists[i] = &List{
+			elements:   v.elements[gl.FirstUsed:end],
+			firstEmpty: v.firstEmpty,
+			firstUsed:  gl.FirstUsed,
+		}
+	}
+	return lists, nil
+}
@@ -0,0 +1,106 @@
+package lst
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func TestGob(t *testing.T) {
+	l := L(1, L("a", "b"), nil, 4.5)
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(l); err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded *List
+	if err := gob.NewDecoder(&buf).Decode(&decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if !DeepEqual(decoded, l) {
+		t.Errorf("Decoding gave %v", decoded)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	base := L(3, 4, 5)
+	first := Cons(2, base)
+	second := Cons(1, first)
+	tail := Tail(base)
+	init := Init(second)
+	other := L("x")
+
+	var buf bytes.Buffer
+	if err := EncodeSnapshot(&buf, second, tail, nil, first, other, init); err != nil {
+		t.Fatal(err)
+	}
+
+	lists, err := DecodeSnapshot(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []*List{second, tail, nil, first, other, init}
+	if len(lists) != len(expected) {
+		t.Fatalf("Decoded %d lists instead of %d", len(lists), len(expected))
+	}
+
+	for i, l := range lists {
+		if (l == nil) != (expected[i] == nil) || l != nil && !Equal(l, expected[i]) {
+			t.Errorf("List %d decoded as %v instead of %v", i, l, expected[i])
+		}
+	}
+
+	shared := []*List{lists[0], lists[3], lists[5]}
+	for _, l := range shared {
+		if l.firstEmpty != lists[0].firstEmpty {
+			t.Errorf("%v doesn't share the vector of %v", l, lists[0])
+		}
+	}
+
+	// Consing to base copied its vector, which was full
+	if lists[1].firstEmpty == lists[0].firstEmpty || lists[4].firstEmpty == lists[0].firstEmpty {
+		t.Error("Unrelated lists shouldn't share a vector")
+	}
+
+	// The slot after first is taken by second, so consing to first copies it,
+	// while consing to second claims the next slot
+	decodedFirst, decodedSecond := lists[3], lists[0]
+	if c := Cons(9, decodedFirst); c.firstEmpty == decodedFirst.firstEmpty {
+		t.Error("Cons should have copied the vector")
+	}
+
+	if c := Cons(0, decodedSecond); c.firstEmpty != decodedSecond.firstEmpty || !Equal(c, L(0, 1, 2, 3, 4, 5)) {
+		t.Errorf("Cons should have shared the vector, giving %v", c)
+	}
+
+	if !Equal(decodedFirst, L(2, 3, 4, 5)) {
+		t.Errorf("Consing changed another list: %v", decodedFirst)
+	}
+}
+
+func TestSnapshotOfUnclaimedSlots(t *testing.T) {
+	l := L(1, 2, 3)
+	Cons(0, l) // Claims a slot not included in the snapshot
+	tail := Tail(l)
+
+	var buf bytes.Buffer
+	if err := EncodeSnapshot(&buf, tail); err != nil {
+		t.Fatal(err)
+	}
+
+	lists, err := DecodeSnapshot(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !Equal(lists[0], L(2, 3)) {
+		t.Errorf("Decoding gave %v", lists[0])
+	}
+
+	if c := Cons(1, lists[0]); !Equal(c, L(1, 2, 3)) {
+		t.Errorf("Cons gave %v", c)
+	}
+}
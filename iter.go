@@ -0,0 +1,78 @@
+package lst
+
+import (
+	"iter"
+)
+
+/*
+ * Iterators to be used with the range-over-func loops. Unlike MakeIterator,
+ * they don't rely on a nil sentinel, so lists containing nil are fully
+ * traversed.
+ */
+
+// Enumerate gives an iterator over the indices and elements of the list, from
+// head to last.
+//
+// Example:
+//
+// for i, x := range Enumerate(L("a", "b")) {
+// 	fmt.Println(i, x)
+// }
+// -> 0 a
+//    1 b
+func Enumerate(l *List) iter.Seq2[int, Elem] {
+	return func(yield func(int, Elem) bool) {
+		for i := 0; i < Len(l); i++ {
+			if !yield(i, Get(l, i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values gives an iterator over the elements of the list, from head to last.
+//
+// Example:
+//
+// slice := slices.Collect(Values(L(1, 2, 3)))
+// -> slice = []Elem{1, 2, 3}
+func Values(l *List) iter.Seq[Elem] {
+	return func(yield func(Elem) bool) {
+		for i := 0; i < Len(l); i++ {
+			if !yield(Get(l, i)) {
+				return
+			}
+		}
+	}
+}
+
+// Backward gives an iterator over the indices and elements of the list, from
+// last to head.
+func Backward(l *List) iter.Seq2[int, Elem] {
+	return func(yield func(int, Elem) bool) {
+		for i := Len(l) - 1; i >= 0; i-- {
+			if !yield(i, Get(l, i)) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq creates a new list with the elements produced by the iterator, in
+// the same order.
+//
+// Example:
+//
+// l := FromSeq(slices.Values([]Elem{1, 2, 3}))
+// -> l = [1, 2, 3]
+func FromSeq(seq iter.Seq[Elem]) *List {
+	var elems []Elem
+	for x := range seq {
+		elems = append(elems, x)
+	}
+
+	return fromSlice(elems)
+}
+
+// Synonym for FromSeq
+var Collect = FromSeq
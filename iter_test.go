@@ -0,0 +1,77 @@
+package lst
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestEnumerate(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	count := 0
+	for i, x := range Enumerate(l) {
+		if i != count || x != elements[i] {
+			t.Errorf("Mismatched elements at index %d", i)
+		}
+		count++
+	}
+
+	if count != N {
+		t.Errorf("Iterated over %d elements instead of %d", count, N)
+	}
+}
+
+func TestValuesWithNil(t *testing.T) {
+	l := L(1, nil, 3)
+	values := slices.Collect(Values(l))
+
+	if len(values) != 3 || values[0] != 1 || values[1] != nil || values[2] != 3 {
+		t.Errorf("Got %v instead of [1 <nil> 3]", values)
+	}
+}
+
+func TestValuesBreak(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	count := 0
+	for range Values(l) {
+		count++
+		if count == 10 {
+			break
+		}
+	}
+
+	if count != 10 {
+		t.Errorf("Loop didn't stop at the tenth element")
+	}
+}
+
+func TestBackward(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	expected := N - 1
+	for i, x := range Backward(l) {
+		if i != expected || x != elements[i] {
+			t.Errorf("Mismatched elements at index %d", i)
+		}
+		expected--
+	}
+
+	if expected != -1 {
+		t.Errorf("Iteration stopped at index %d", expected+1)
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	l := FromSeq(slices.Values(elements[:]))
+
+	if !Equal(l, NewFromSlice(elements[:])) {
+		t.Error("List differs from the original slice")
+	}
+
+	l2 := Cons(0, l)
+	if Len(l2) != N+1 || Head(l2) != 0 || Get(l2, 1) != elements[0] {
+		t.Error("Cons is misbehaving on a list created by FromSeq")
+	}
+
+	if !Empty(Collect(Values(New()))) {
+		t.Error("Collecting an empty iterator gave a non-empty list")
+	}
+}
@@ -0,0 +1,78 @@
+package lst
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+)
+
+/*
+ * Lists are encoded as JSON arrays, from head to last, the same order String
+ * shows them. Nested lists become nested arrays and vice versa.
+ */
+
+// MarshalJSON encodes the list as a JSON array.
+//
+// Example:
+//
+// json.Marshal(L(1, L("a", "b"), 3))
+// -> [1,["a","b"],3]
+func (l *List) MarshalJSON() ([]byte, error) {
+	// Appending to a non-nil slice, so that empty lists give [] instead of null
+	return json.Marshal(slices.AppendSeq(make([]Elem, 0, Len(l)), Values(l)))
+}
+
+// UnmarshalJSON decodes a JSON array into the list. Nested arrays are decoded
+// as lists, and the other values as json.Unmarshal would decode them into an
+// interface{}: numbers as float64, objects as map[string]interface{} and so
+// on. Use DecodeJSON to decode the elements into a concrete type.
+func (l *List) UnmarshalJSON(data []byte) error {
+	// By convention, null leaves the value unchanged
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	elems := make([]Elem, len(raw))
+	for i, r := range raw {
+		if len(r) > 0 && r[0] == '[' {
+			nested := new(List)
+			if err := nested.UnmarshalJSON(r); err != nil {
+				return err
+			}
+			elems[i] = nested
+			continue
+		}
+
+		if err := json.Unmarshal(r, &elems[i]); err != nil {
+			return err
+		}
+	}
+
+	*l = *fromSlice(elems)
+	return nil
+}
+
+// DecodeJSON decodes a JSON array into a list whose elements have type T.
+//
+// Example:
+//
+// type point struct{ X, Y int }
+// l, err := DecodeJSON[point]([]byte(`[{"X": 1, "Y": 2}]`))
+// -> l = [{1 2}]
+func DecodeJSON[T any](data []byte) (*List, error) {
+	var slice []T
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return nil, err
+	}
+
+	elems := make([]Elem, len(slice))
+	for i, x := range slice {
+		elems[i] = x
+	}
+	return fromSlice(elems), nil
+}
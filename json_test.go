@@ -0,0 +1,76 @@
+package lst
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	cases := []struct {
+		list     *List
+		expected string
+	}{
+		{New(), `[]`},
+		{L(1, 2, 3), `[1,2,3]`},
+		{Cons("a", L("b")), `["a","b"]`},
+		{L(1, L("a", L()), nil), `[1,["a",[]],null]`},
+	}
+
+	for _, c := range cases {
+		data, err := json.Marshal(c.list)
+		if err != nil {
+			t.Fatalf("Marshalling %v: %v", c.list, err)
+		}
+
+		if string(data) != c.expected {
+			t.Errorf("%v was marshalled to %s", c.list, data)
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var l *List
+	if err := json.Unmarshal([]byte(`[1, ["a", []], {"b": true}, null]`), &l); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := L(1.0, L("a", New()), map[string]interface{}{"b": true}, nil)
+	if !DeepEqual(l, expected) {
+		t.Errorf("Unmarshalling gave %v", l)
+	}
+
+	var wrapper struct {
+		List *List
+	}
+	data, _ := json.Marshal(struct{ List *List }{L(3, 4)})
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		t.Fatal(err)
+	}
+
+	if !Equal(wrapper.List, L(3.0, 4.0)) {
+		t.Errorf("Unmarshalling a field gave %v", wrapper.List)
+	}
+
+	if err := json.Unmarshal([]byte(`{"a": 1}`), &l); err == nil {
+		t.Error("Unmarshalling an object should fail")
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+
+	l, err := DecodeJSON[point]([]byte(`[{"X": 1, "Y": 2}, {"X": 3}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !Equal(l, L(point{1, 2}, point{3, 0})) {
+		t.Errorf("DecodeJSON gave %v", l)
+	}
+
+	if _, err := DecodeJSON[int]([]byte(`["a"]`)); err == nil {
+		t.Error("Decoding a string as int should fail")
+	}
+}
@@ -20,9 +20,9 @@
 package lst
 
 import (
-	"fmt"
 	"reflect"
 	"strings"
+	"sync/atomic"
 )
 
 type Elem interface{}
@@ -30,14 +30,14 @@ type Elem interface{}
 type List struct {
 	elements []Elem
 	// See Cons function for a better understanding of the following 2 fields
-	firstEmpty *int
+	firstEmpty *atomic.Int64
 	firstUsed  int
 }
 
 func New() *List {
 	l := new(List)
 	l.elements = make([]Elem, 0)
-	l.firstEmpty = new(int)
+	l.firstEmpty = new(atomic.Int64)
 
 	return l
 }
@@ -54,11 +54,28 @@ func newFromReversedSlice(slice []Elem) (l *List) {
 	l = new(List)
 	l.elements = make([]Elem, len(slice))
 	copy(l.elements, slice)
-	length := len(slice)
-	l.firstEmpty = &length
+	l.firstEmpty = newCounter(len(slice))
 	return
 }
 
+// fromElements creates a list owning the given vector, which must already be
+// in the reverse order
+func fromElements(elements []Elem) (l *List) {
+	l = new(List)
+	l.elements = elements
+	l.firstEmpty = newCounter(len(elements))
+	return
+}
+
+// fromSlice creates a list owning the given vector, whose elements are in the
+// same order they'll have in the list. The vector is reversed in place.
+func fromSlice(elems []Elem) *List {
+	for i, j := 0, len(elems)-1; i < j; i, j = i+1, j-1 {
+		elems[i], elems[j] = elems[j], elems[i]
+	}
+	return fromElements(elems)
+}
+
 func NewFromSlice(slice interface{}) (l *List) {
 	value := reflect.ValueOf(slice)
 	if value.Kind() != reflect.Slice {
@@ -72,8 +89,7 @@ func NewFromSlice(slice interface{}) (l *List) {
 		set(l, i, value.Index(i).Interface())
 	}
 
-	i := value.Len()
-	l.firstEmpty = &i
+	l.firstEmpty = newCounter(value.Len())
 	return
 }
 
@@ -83,6 +99,13 @@ func NewWithElements(elems ...Elem) (l *List) {
 
 var L = NewWithElements // Just for convenience
 
+// newCounter creates the firstEmpty counter of a newly allocated vector
+func newCounter(firstEmpty int) *atomic.Int64 {
+	counter := new(atomic.Int64)
+	counter.Store(int64(firstEmpty))
+	return counter
+}
+
 func Len(l *List) int {
 	return len(l.elements)
 }
@@ -96,7 +119,7 @@ func (l *List) String() string {
 	elems := make([]string, last+1)
 
 	for k, v := range l.elements {
-		elems[last-k] = fmt.Sprintf("%v", v)
+		elems[last-k] = show(v)
 	}
 
 	return "[" + strings.Join(elems, ", ") + "]"
@@ -113,7 +136,8 @@ func set(l *List, i int, x Elem) {
 }
 
 // MakeIterator creates a function one can use to iterate over the elements of 
-// the list. A "nil" value signalises the end of the loop.
+// the list. A "nil" value signalises the end of the loop, so lists containing
+// nil should be traversed with Values or Enumerate instead.
 //
 // Example:
 //
@@ -159,12 +183,8 @@ func Head(l *List) Elem {
 }
 
 // Gets all but the head of the list
-func Tail(l *List) (tailList *List) {
-	tailList = new(List)
-	tailList.elements = l.elements[:Len(l)-1]
-	tailList.firstEmpty = l.firstEmpty
-	tailList.firstUsed = l.firstUsed
-	return
+func Tail(l *List) *List {
+	return view(l, 0, Len(l)-1)
 }
 
 // Gets the last element of the list (the first inserted element)
@@ -173,12 +193,76 @@ func Last(l *List) Elem {
 }
 
 // Gets all but the last element of the list
-func Init(l *List) (initList *List) {
-	initList = new(List)
-	initList.elements = l.elements[1:]
-	initList.firstEmpty = l.firstEmpty
-	initList.firstUsed = l.firstUsed + 1
-	return
+func Init(l *List) *List {
+	return view(l, 1, Len(l))
+}
+
+// view creates a list sharing the vector of l, using only the elements from
+// start (inclusive) to end (exclusive) of its reversed slice. It may be
+// compacted instead, if SetAutoCompact says so.
+func view(l *List, start, end int) *List {
+	v := new(List)
+	v.elements = l.elements[start:end]
+	v.firstEmpty = l.firstEmpty
+	v.firstUsed = l.firstUsed + start
+	return autoCompacted(v)
+}
+
+// clamp limits n to the interval [0, Len(l)]
+func clamp(l *List, n int) int {
+	return min(max(n, 0), Len(l))
+}
+
+// Take gives the first n elements of the list, or the whole list if it has
+// less than n elements. It shares the vector of the original list, so it
+// takes constant time.
+//
+// Example:
+//
+// Take(L(1, 2, 3, 4), 2)
+// -> [1, 2]
+func Take(l *List, n int) *List {
+	n = clamp(l, n)
+	return view(l, Len(l)-n, Len(l))
+}
+
+// Drop gives all but the first n elements of the list. Like Take, it takes
+// constant time.
+//
+// Example:
+//
+// Drop(L(1, 2, 3, 4), 2)
+// -> [3, 4]
+func Drop(l *List, n int) *List {
+	n = clamp(l, n)
+	return view(l, 0, Len(l)-n)
+}
+
+// SplitAt breaks the list in two at the index i. It's the same as calling Take
+// and Drop.
+//
+// Example:
+//
+// first, rest := SplitAt(L(1, 2, 3, 4), 1)
+// -> first = [1]
+//    rest = [2, 3, 4]
+func SplitAt(l *List, i int) (first, rest *List) {
+	return Take(l, i), Drop(l, i)
+}
+
+// Slice gives the elements of the list from index i (inclusive) to j
+// (exclusive), in constant time.
+//
+// Example:
+//
+// Slice(L(1, 2, 3, 4, 5), 1, 3)
+// -> [2, 3]
+func Slice(l *List, i, j int) *List {
+	i, j = clamp(l, i), clamp(l, j)
+	if j < i {
+		j = i
+	}
+	return view(l, Len(l)-j, Len(l)-i)
 }
 
 // The list constructor. It constructs a new list by inserting a new element in
@@ -193,32 +277,45 @@ func Init(l *List) (initList *List) {
 // third := Cons(2, second)
 func Cons(x Elem, l *List) (newl *List) {
 	/*
-	 * O vetor que efetivamente armazena os elementos da lista pode ser 
-	 * compartilhado por diversas listas. Assim, é preciso ter cuidado ao 
-	 * inserir um novo elemento, para não sobrescrever um elemento que tenha 
-	 * sido inserido por outra lista que compartilhe esse mesmo vetor. Para 
-	 * isso é que servem os atributos firstEmpty e firstUsed
+	 * O vetor que efetivamente armazena os elementos da lista pode ser
+	 * compartilhado por diversas listas, possivelmente em goroutines
+	 * distintas. Assim, é preciso ter cuidado ao inserir um novo elemento,
+	 * para não sobrescrever um elemento que tenha sido inserido por outra
+	 * lista que compartilhe esse mesmo vetor. Para isso é que servem os
+	 * atributos firstEmpty e firstUsed: a posição seguinte à cabeça da lista
+	 * só pode ser usada por quem conseguir avançar firstEmpty atomicamente
 	 */
-	if *l.firstEmpty > Len(l)+l.firstUsed {
-		// Neste caso, a posição desejada do vetor já está sendo ocupada. É
-		// necessário fazer uma cópia portanto
-		newl = newFromReversedSlice(l.elements)
-	} else {
+	length := Len(l)
+	slot := int64(l.firstUsed + length)
+	if length < cap(l.elements) && l.firstEmpty.CompareAndSwap(slot, slot+1) {
 		newl = NewFromList(l)
+		newl.elements = l.elements[:length+1]
+		newl.elements[length] = x
+		return
 	}
 
-	if Len(l) == cap(l.elements) {
-		// Neste caso, a função append que será usada a seguir vai alocar um 
-		// novo vetor, o que obriga a reconfigurar as variáveis firsEmpty e
-		// firstUsed
-		*newl.firstEmpty = Len(l) + 1
-		newl.firstUsed = 0
-	} else {
-		*newl.firstEmpty++
-	}
+	// Neste caso, a posição desejada do vetor já está ocupada ou não existe.
+	// É necessário fazer uma cópia portanto, e o novo vetor terá seu próprio
+	// firstEmpty. Sua capacidade é dada pela estratégia de crescimento
+	elements := make([]Elem, length+1, capacityFor(length))
+	copy(elements, l.elements)
+	elements[length] = x
+	return fromElements(elements)
+}
 
-	newl.elements = append(newl.elements, x)
-	return
+// Snoc constructs a new list by inserting a new element at the end of an old
+// one. Unlike Cons, it can't share the vector of the original list, so it
+// takes linear time. Use a Deque if you need to do it often.
+//
+// Example:
+//
+// Snoc(L(1, 2), 3)
+// -> [1, 2, 3]
+func Snoc(l *List, x Elem) *List {
+	elems := make([]Elem, Len(l)+1)
+	elems[0] = x
+	copy(elems[1:], l.elements)
+	return fromElements(elems)
 }
 
 // Foldr makes a fold in the list from right to left. For each element, it 
@@ -272,7 +369,8 @@ func concatenate(l1, l2 *List) (con *List) {
 	return Foldr(l2, l1, cons).(*List)
 }
 
-// Concatenates all the lists given as arguments.
+// Concatenates all the lists given as arguments. Without arguments, it gives
+// an empty list.
 //
 // Example:
 //
@@ -283,6 +381,10 @@ func concatenate(l1, l2 *List) (con *List) {
 //
 // -> c = [1, 2, 3, 4, 5, 6]
 func Concatenate(lists ...*List) (con *List) {
+	if len(lists) == 0 {
+		return New()
+	}
+
 	last := len(lists) - 1
 	con = lists[last]
 	for i := last - 1; i >= 0; i-- {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -251,3 +252,121 @@ func TestConcatenate(t *testing.T) {
 		}
 	}
 }
+
+func TestConsAfterReallocation(t *testing.T) {
+	l := L(1, 2, 3)
+	Cons(4, Init(Init(l)))
+	Cons(5, Tail(l))
+
+	if !Equal(l, L(1, 2, 3)) {
+		t.Errorf("Cons overwrote an element of a list sharing its vector: %v", l)
+	}
+}
+
+func TestConsConcurrently(t *testing.T) {
+	const goroutines = 8
+
+	base := New()
+	for i := 0; i < N; i++ {
+		base = Cons(i, base)
+	}
+	tail := Tail(base)
+
+	var wg sync.WaitGroup
+	results := make([][]*List, goroutines)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < N; i++ {
+				results[g] = append(results[g], Cons(g, base), Cons(g, tail))
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	for g, lists := range results {
+		for _, l := range lists {
+			if Head(l) != g {
+				t.Fatalf("Element inserted by goroutine %d was overwritten by %v", g, Head(l))
+			}
+			if !Equal(Tail(l), base) && !Equal(Tail(l), tail) {
+				t.Fatalf("Cons changed the elements of the original list")
+			}
+		}
+	}
+}
+
+func TestTakeAndDrop(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	for _, n := range []int{-1, 0, 1, N / 2, N, N + 1} {
+		k := min(max(n, 0), N)
+		if !Equal(Take(l, n), NewFromSlice(elements[:k])) {
+			t.Errorf("Take(%d) gave the wrong elements", n)
+		}
+		if !Equal(Drop(l, n), NewFromSlice(elements[k:])) {
+			t.Errorf("Drop(%d) gave the wrong elements", n)
+		}
+	}
+}
+
+func TestSplitAt(t *testing.T) {
+	first, rest := SplitAt(L(1, 2, 3, 4), 1)
+	if !Equal(first, L(1)) || !Equal(rest, L(2, 3, 4)) {
+		t.Errorf("SplitAt returned %v and %v", first, rest)
+	}
+}
+
+func TestSlice(t *testing.T) {
+	l := L(1, 2, 3, 4, 5)
+	cases := []struct {
+		i, j     int
+		expected *List
+	}{
+		{1, 3, L(2, 3)},
+		{0, 5, l},
+		{3, 3, New()},
+		{4, 2, New()},
+		{-2, 10, l},
+	}
+
+	for _, c := range cases {
+		if s := Slice(l, c.i, c.j); !Equal(s, c.expected) {
+			t.Errorf("Slice(%d, %d) returned %v instead of %v", c.i, c.j, s, c.expected)
+		}
+	}
+}
+
+func TestConsOnViews(t *testing.T) {
+	l := New()
+	for i := 5; i > 0; i-- {
+		l = Cons(i, l)
+	}
+
+	taken := Cons(0, Take(l, 2))
+	dropped := Cons(0, Drop(l, 2))
+	sliced := Cons(0, Slice(l, 1, 3))
+	empty := Cons(0, Take(l, 0))
+
+	switch {
+	case !Equal(l, L(1, 2, 3, 4, 5)):
+		t.Errorf("Cons on a view changed the original list to %v", l)
+	case !Equal(taken, L(0, 1, 2)):
+		t.Errorf("Cons on Take gave %v", taken)
+	case !Equal(dropped, L(0, 3, 4, 5)):
+		t.Errorf("Cons on Drop gave %v", dropped)
+	case !Equal(sliced, L(0, 2, 3)):
+		t.Errorf("Cons on Slice gave %v", sliced)
+	case !Equal(empty, L(0)):
+		t.Errorf("Cons on an empty view gave %v", empty)
+	}
+}
+
+func TestSnoc(t *testing.T) {
+	l := L(1, 2)
+	snoc := Snoc(l, 3)
+
+	if !Equal(snoc, L(1, 2, 3)) || !Equal(l, L(1, 2)) {
+		t.Errorf("Snoc gave %v and changed the original list to %v", snoc, l)
+	}
+}
@@ -0,0 +1,120 @@
+package lst
+
+import (
+	"runtime"
+	"sync"
+)
+
+/*
+ * Parallel versions of some functions. The vector of the list is split in
+ * chunks, one for each of at most GOMAXPROCS goroutines. The functions given
+ * to them are thus called concurrently, and in no particular order, but the
+ * results keep the order of the list. If any call panics, the panic is
+ * propagated to the caller once all goroutines have finished.
+ */
+
+// chunks gives the number of chunks a vector of length n is split in
+func chunks(n int) int {
+	return min(runtime.GOMAXPROCS(0), n)
+}
+
+// parallel splits a vector of length n in k chunks, and calls f for each of
+// them in its own goroutine
+func parallel(n, k int, f func(chunk, start, end int)) {
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		panicked bool
+		value    interface{}
+	)
+
+	for c := 0; c < k; c++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					once.Do(func() {
+						panicked, value = true, r
+					})
+				}
+			}()
+
+			f(c, c*n/k, (c+1)*n/k)
+		}()
+	}
+
+	wg.Wait()
+	if panicked {
+		panic(value)
+	}
+}
+
+// ParMap is like Map, but applies f to the elements concurrently.
+//
+// Example:
+//
+// ParMap(L("1", "2", "3"), func(x Elem) Elem {
+// 	n, _ := strconv.Atoi(x.(string))
+// 	return n
+// })
+// -> [1, 2, 3]
+func ParMap(l *List, f func(Elem) Elem) *List {
+	mapped := make([]Elem, Len(l))
+	parallel(Len(l), chunks(Len(l)), func(_, start, end int) {
+		for i := start; i < end; i++ {
+			mapped[i] = f(l.elements[i])
+		}
+	})
+	return fromElements(mapped)
+}
+
+// ParFilter is like Filter, but applies the predicate to the elements
+// concurrently.
+func ParFilter(l *List, f func(Elem) bool) *List {
+	k := chunks(Len(l))
+	kept := make([][]Elem, k)
+	parallel(Len(l), k, func(c, start, end int) {
+		for i := start; i < end; i++ {
+			if f(l.elements[i]) {
+				kept[c] = append(kept[c], l.elements[i])
+			}
+		}
+	})
+
+	var filtered []Elem
+	for _, chunk := range kept {
+		filtered = append(filtered, chunk...)
+	}
+	return fromElements(filtered)
+}
+
+// ParReduce combines the elements of the list with the function op, like
+// Foldl does, but reducing chunks of the list concurrently. For the result to
+// be the same as Foldl's, op must be associative, and identity must be its
+// identity element, which is also the result for an empty list.
+//
+// Example:
+//
+// ParReduce(L(1, 2, 3, 4), 0, func(x, y Elem) Elem {
+// 	return x.(int) + y.(int)
+// })
+// -> 10
+func ParReduce(l *List, identity Elem, op func(x, y Elem) Elem) Elem {
+	k := chunks(Len(l))
+	results := make([]Elem, k)
+	parallel(Len(l), k, func(c, start, end int) {
+		// The vector is in reverse order
+		acc := identity
+		for i := end - 1; i >= start; i-- {
+			acc = op(acc, l.elements[i])
+		}
+		results[c] = acc
+	})
+
+	acc := identity
+	for c := k - 1; c >= 0; c-- {
+		acc = op(acc, results[c])
+	}
+	return acc
+}
@@ -0,0 +1,125 @@
+package lst
+
+import (
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+func TestParMap(t *testing.T) {
+	for _, n := range []int{0, 1, 7, 1000} {
+		l := New()
+		for i := n - 1; i >= 0; i-- {
+			l = Cons(strconv.Itoa(i), l)
+		}
+
+		mapped := ParMap(l, func(x Elem) Elem {
+			n, _ := strconv.Atoi(x.(string))
+			return n
+		})
+
+		if Len(mapped) != n {
+			t.Fatalf("ParMap gave %d elements instead of %d", Len(mapped), n)
+		}
+
+		for i, x := range Enumerate(mapped) {
+			if x != i {
+				t.Errorf("ParMap gave %v at index %d", x, i)
+				break
+			}
+		}
+	}
+}
+
+func TestParFilter(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	even := func(x Elem) bool {
+		return x.(int)%2 == 0
+	}
+
+	if p, s := ParFilter(l, even), Filter(l, even); !Equal(p, s) {
+		t.Errorf("ParFilter gave %v instead of %v", p, s)
+	}
+
+	if p := ParFilter(New(), even); !Empty(p) {
+		t.Errorf("ParFilter of an empty list gave %v", p)
+	}
+}
+
+func TestParReduce(t *testing.T) {
+	l := NewFromSlice(elements[:])
+
+	// Concatenation is associative, but not commutative
+	concat := func(x, y Elem) Elem {
+		return x.(string) + y.(string)
+	}
+	strings := Map(l, func(x Elem) Elem {
+		return strconv.Itoa(x.(int)) + ","
+	})
+
+	p := ParReduce(strings, "", concat)
+	s := Foldl("", strings, func(acc interface{}, x Elem) interface{} {
+		return concat(acc, x)
+	})
+
+	if p != s {
+		t.Errorf("ParReduce gave %v instead of %v", p, s)
+	}
+
+	if p := ParReduce(New(), 0, nil); p != 0 {
+		t.Errorf("ParReduce of an empty list gave %v", p)
+	}
+}
+
+func TestParPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "bad element" {
+			t.Errorf("Recovered %v", r)
+		}
+	}()
+
+	ParMap(NewFromSlice(elements[:]), func(x Elem) Elem {
+		if x.(int) == elements[N/2] {
+			panic("bad element")
+		}
+		return x
+	})
+
+	t.Error("ParMap should have panicked")
+}
+
+func TestParConcurrentLists(t *testing.T) {
+	// Run with -race: workers only read the shared vector while other
+	// goroutines cons to lists sharing it. Consing to a full vector copies it,
+	// so l must have spare capacity.
+	l := Cons(1, NewFromSlice(elements[:]))
+	done := make(chan *List)
+	go func() {
+		done <- Cons(0, l)
+	}()
+
+	double := ParMap(l, func(x Elem) Elem {
+		return 2 * x.(int)
+	})
+	<-done
+
+	if Len(double) != N+1 || Get(double, 0) != 2*Get(l, 0).(int) {
+		t.Errorf("ParMap gave %v", double)
+	}
+}
+
+func TestParChangingGOMAXPROCS(t *testing.T) {
+	procs := runtime.GOMAXPROCS(1)
+	defer runtime.GOMAXPROCS(procs)
+
+	l := NewFromSlice(elements[:])
+	even := func(x Elem) bool {
+		// Raising GOMAXPROCS while ParFilter runs mustn't break it
+		runtime.GOMAXPROCS(8)
+		return x.(int)%2 == 0
+	}
+
+	if p, s := ParFilter(l, even), Filter(l, even); !Equal(p, s) {
+		t.Errorf("ParFilter gave %v instead of %v", p, s)
+	}
+}
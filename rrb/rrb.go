@@ -0,0 +1,391 @@
+/*
+   Package rrb provides an alternative representation for lists, based on a
+   relaxed radix balanced tree, for programs which concatenate, split or index
+   big lists very often. Like lists from package lst, vectors are immutable
+   and share their structure, but those three operations take logarithmic
+   time.
+
+   To create a vector from a list, type:
+
+   	v := rrb.FromList(l)
+
+   Or, as a shorthand,
+
+   	v := rrb.L(1, 2, 3, 4)
+
+   Every internal node keeps a table with the accumulated sizes of its
+   children (the "relaxed" part of the tree), and every node but the root has
+   between maxChildren/2 and maxChildren children, which keeps the tree
+   balanced even after many concatenations and splits.
+*/
+package rrb
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+	"strings"
+
+	"github.com/gustavo-hms/lst"
+)
+
+const (
+	maxChildren = 32
+	minChildren = maxChildren / 2
+)
+
+type node struct {
+	// Leaves use only the elements field; internal nodes use the other two
+	elements []lst.Elem
+	children []*node
+	// sizes[i] is the number of elements stored below children[0..i]
+	sizes []int
+}
+
+func newLeaf(elements []lst.Elem) *node {
+	return &node{elements: elements}
+}
+
+func newBranch(children []*node) *node {
+	n := &node{children: children, sizes: make([]int, len(children))}
+	total := 0
+	for i, child := range children {
+		total += child.size()
+		n.sizes[i] = total
+	}
+	return n
+}
+
+func (n *node) leaf() bool {
+	return n.children == nil
+}
+
+func (n *node) size() int {
+	if n.leaf() {
+		return len(n.elements)
+	}
+	return n.sizes[len(n.sizes)-1]
+}
+
+func (n *node) width() int {
+	if n.leaf() {
+		return len(n.elements)
+	}
+	return len(n.children)
+}
+
+// child finds the child holding the i-th element of the node, and the index of
+// that element inside the child
+func (n *node) child(i int) (int, int) {
+	j := sort.SearchInts(n.sizes, i+1)
+	if j > 0 {
+		i -= n.sizes[j-1]
+	}
+	return j, i
+}
+
+type Vector struct {
+	root *node // nil for the empty vector
+	// Leaves have height 0
+	height int
+}
+
+func New() *Vector {
+	return new(Vector)
+}
+
+// FromSlice creates a vector with the elements of a slice, in the same order.
+func FromSlice(elems []lst.Elem) *Vector {
+	if len(elems) == 0 {
+		return New()
+	}
+
+	var level []*node
+	for _, bounds := range chunks(len(elems)) {
+		leafElems := make([]lst.Elem, bounds[1]-bounds[0])
+		copy(leafElems, elems[bounds[0]:bounds[1]])
+		level = append(level, newLeaf(leafElems))
+	}
+
+	height := 0
+	for len(level) > 1 {
+		var parents []*node
+		for _, bounds := range chunks(len(level)) {
+			parents = append(parents, newBranch(level[bounds[0]:bounds[1]:bounds[1]]))
+		}
+		level = parents
+		height++
+	}
+
+	return &Vector{level[0], height}
+}
+
+// chunks splits n items in the least number of groups of at most maxChildren
+// items, making the groups as even as possible, so that all of them have at
+// least minChildren items when there is more than one group
+func chunks(n int) (bounds [][2]int) {
+	k := (n + maxChildren - 1) / maxChildren
+	for i := 0; i < k; i++ {
+		bounds = append(bounds, [2]int{i * n / k, (i + 1) * n / k})
+	}
+	return
+}
+
+func NewWithElements(elems ...lst.Elem) *Vector {
+	return FromSlice(elems)
+}
+
+var L = NewWithElements // Just for convenience
+
+// FromList creates a vector with the elements of a list.
+func FromList(l *lst.List) *Vector {
+	elems := make([]lst.Elem, 0, lst.Len(l))
+	for x := range lst.Values(l) {
+		elems = append(elems, x)
+	}
+	return FromSlice(elems)
+}
+
+// ToList creates a list with the elements of a vector.
+func ToList(v *Vector) *lst.List {
+	return lst.FromSeq(Values(v))
+}
+
+func Len(v *Vector) int {
+	if v.root == nil {
+		return 0
+	}
+	return v.root.size()
+}
+
+// Tells if a vector is empty
+func Empty(v *Vector) bool {
+	return v.root == nil
+}
+
+// Get gives the i-th element of the vector in logarithmic time.
+func Get(v *Vector, i int) lst.Elem {
+	if i < 0 || i >= Len(v) {
+		panic(fmt.Sprintf("Index %d out of range for a vector of length %d", i, Len(v)))
+	}
+
+	n := v.root
+	for !n.leaf() {
+		var j int
+		j, i = n.child(i)
+		n = n.children[j]
+	}
+	return n.elements[i]
+}
+
+// Gets the head of the vector
+func Head(v *Vector) lst.Elem {
+	return Get(v, 0)
+}
+
+// Gets all but the head of the vector
+func Tail(v *Vector) *Vector {
+	_, tail := SplitAt(v, 1)
+	return tail
+}
+
+// Cons creates a new vector by inserting an element in the front of an old
+// one.
+func Cons(x lst.Elem, v *Vector) *Vector {
+	return Concatenate(L(x), v)
+}
+
+// Values gives an iterator over the elements of the vector.
+func Values(v *Vector) iter.Seq[lst.Elem] {
+	return func(yield func(lst.Elem) bool) {
+		if v.root != nil {
+			walk(v.root, yield)
+		}
+	}
+}
+
+func walk(n *node, yield func(lst.Elem) bool) bool {
+	if n.leaf() {
+		for _, x := range n.elements {
+			if !yield(x) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, child := range n.children {
+		if !walk(child, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v *Vector) String() string {
+	elems := make([]string, 0, Len(v))
+	for x := range Values(v) {
+		elems = append(elems, fmt.Sprintf("%v", x))
+	}
+	return "[" + strings.Join(elems, ", ") + "]"
+}
+
+// Concatenates all the vectors given as arguments. Each concatenation takes
+// logarithmic time.
+//
+// Example:
+//
+// v1 := L(1, 2)
+// v2 := L(3, 4)
+// v3 := L(5, 6)
+// c := Concatenate(v1, v2, v3)
+//
+// -> c = [1, 2, 3, 4, 5, 6]
+func Concatenate(vectors ...*Vector) (con *Vector) {
+	con = New()
+	for _, v := range vectors {
+		con = concatenate(con, v)
+	}
+	return
+}
+
+func concatenate(v1, v2 *Vector) *Vector {
+	switch {
+	case Empty(v1):
+		return v2
+	case Empty(v2):
+		return v1
+	}
+
+	var nodes []*node
+	height := v1.height
+	if v1.height >= v2.height {
+		nodes = joinRight(v1.root, v1.height, v2.root, v2.height)
+	} else {
+		nodes = joinLeft(v1.root, v1.height, v2.root, v2.height)
+		height = v2.height
+	}
+
+	if len(nodes) == 1 {
+		return &Vector{nodes[0], height}
+	}
+	return &Vector{newBranch(nodes), height + 1}
+}
+
+// joinRight puts the tree r, which is not higher than l, at the right end of
+// l. It returns one or two nodes with the same height as l.
+func joinRight(l *node, lh int, r *node, rh int) []*node {
+	if lh == rh {
+		return merge(l, r)
+	}
+
+	last := len(l.children) - 1
+	joined := joinRight(l.children[last], lh-1, r, rh)
+	children := make([]*node, 0, last+len(joined))
+	children = append(children, l.children[:last]...)
+	children = append(children, joined...)
+	return branches(children)
+}
+
+// joinLeft puts the tree l, which is lower than r, at the left end of r. It
+// returns one or two nodes with the same height as r.
+func joinLeft(l *node, lh int, r *node, rh int) []*node {
+	if lh == rh {
+		return merge(l, r)
+	}
+
+	joined := joinLeft(l, lh, r.children[0], rh-1)
+	children := make([]*node, 0, len(joined)+len(r.children)-1)
+	children = append(children, joined...)
+	children = append(children, r.children[1:]...)
+	return branches(children)
+}
+
+// branches creates a node with the given children, or two nodes if they
+// don't fit in a single one
+func branches(children []*node) []*node {
+	if len(children) <= maxChildren {
+		return []*node{newBranch(children)}
+	}
+
+	half := len(children) / 2
+	return []*node{newBranch(children[:half:half]), newBranch(children[half:])}
+}
+
+// merge combines two nodes of the same height. Since one of them may be the
+// root of a tree, and thus have too few children, their children are
+// redistributed whenever they don't fit in a single node.
+func merge(l, r *node) []*node {
+	if l.leaf() {
+		elems := make([]lst.Elem, 0, l.width()+r.width())
+		elems = append(elems, l.elements...)
+		elems = append(elems, r.elements...)
+		if len(elems) <= maxChildren {
+			return []*node{newLeaf(elems)}
+		}
+
+		half := len(elems) / 2
+		return []*node{newLeaf(elems[:half:half]), newLeaf(elems[half:])}
+	}
+
+	children := make([]*node, 0, l.width()+r.width())
+	children = append(children, l.children...)
+	children = append(children, r.children...)
+	return branches(children)
+}
+
+// SplitAt breaks the vector in two in logarithmic time: the first one has the
+// first i elements of the original vector, and the second one the remaining
+// ones.
+//
+// Example:
+//
+// v := L(1, 2, 3, 4, 5)
+// v1, v2 := SplitAt(v, 2)
+//
+// -> v1 = [1, 2]
+//    v2 = [3, 4, 5]
+func SplitAt(v *Vector, i int) (*Vector, *Vector) {
+	switch {
+	case i <= 0:
+		return New(), v
+	case i >= Len(v):
+		return v, New()
+	}
+	return split(v.root, v.height, i)
+}
+
+func split(n *node, height, i int) (*Vector, *Vector) {
+	if n.leaf() {
+		return &Vector{newLeaf(n.elements[:i:i]), 0}, &Vector{newLeaf(n.elements[i:]), 0}
+	}
+
+	j, i := n.child(i)
+	if i == 0 {
+		return fromChildren(n.children[:j:j], height), fromChildren(n.children[j:], height)
+	}
+
+	left, right := split(n.children[j], height-1, i)
+	left = concatenate(fromChildren(n.children[:j:j], height), left)
+	right = concatenate(right, fromChildren(n.children[j+1:], height))
+	return left, right
+}
+
+// fromChildren creates a vector whose root has the given children
+func fromChildren(children []*node, height int) *Vector {
+	switch len(children) {
+	case 0:
+		return New()
+	case 1:
+		return &Vector{children[0], height - 1}
+	}
+	return &Vector{newBranch(children), height}
+}
+
+// Slice gives the elements of the vector from index i (inclusive) to j
+// (exclusive).
+func Slice(v *Vector, i, j int) *Vector {
+	prefix, _ := SplitAt(v, j)
+	_, slice := SplitAt(prefix, i)
+	return slice
+}
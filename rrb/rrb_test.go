@@ -0,0 +1,153 @@
+package rrb
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/gustavo-hms/lst"
+)
+
+const N = 5000
+
+func sequence(start, end int) []lst.Elem {
+	elems := make([]lst.Elem, 0, end-start)
+	for i := start; i < end; i++ {
+		elems = append(elems, i)
+	}
+	return elems
+}
+
+// check verifies the vector holds the expected elements and that the tree
+// respects its invariants
+func check(t *testing.T, v *Vector, expected []lst.Elem) {
+	t.Helper()
+
+	if Len(v) != len(expected) {
+		t.Fatalf("Vector has %d elements instead of %d", Len(v), len(expected))
+	}
+
+	for i, x := range expected {
+		if Get(v, i) != x {
+			t.Fatalf("Mismatched elements at index %d", i)
+		}
+	}
+
+	if v.root != nil {
+		checkNode(t, v.root, v.height, true)
+	}
+}
+
+func checkNode(t *testing.T, n *node, height int, root bool) {
+	t.Helper()
+
+	if n.leaf() != (height == 0) {
+		t.Fatalf("Leaves at different depths")
+	}
+
+	if n.width() > maxChildren || n.width() == 0 || (!root && n.width() < minChildren) {
+		t.Fatalf("Node with %d children at height %d", n.width(), height)
+	}
+
+	if n.leaf() {
+		return
+	}
+
+	if root && n.width() < 2 {
+		t.Fatalf("Root with a single child")
+	}
+
+	total := 0
+	for i, child := range n.children {
+		checkNode(t, child, height-1, false)
+		total += child.size()
+		if n.sizes[i] != total {
+			t.Fatalf("Wrong size table at height %d", height)
+		}
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	for _, n := range []int{0, 1, maxChildren, maxChildren + 1, N} {
+		check(t, FromSlice(sequence(0, n)), sequence(0, n))
+	}
+}
+
+func TestListConversion(t *testing.T) {
+	l := lst.NewFromSlice(sequence(0, N))
+	v := FromList(l)
+	check(t, v, sequence(0, N))
+
+	if !lst.Equal(ToList(v), l) {
+		t.Error("Converting back gave a different list")
+	}
+}
+
+func TestConcatenate(t *testing.T) {
+	for _, sizes := range [][2]int{{1, 1}, {1, N}, {N, 1}, {40, 40}, {N, N}, {17, 3 * N}} {
+		left := FromSlice(sequence(0, sizes[0]))
+		right := FromSlice(sequence(sizes[0], sizes[0]+sizes[1]))
+		check(t, Concatenate(left, right), sequence(0, sizes[0]+sizes[1]))
+
+		// The original vectors are kept untouched
+		check(t, left, sequence(0, sizes[0]))
+		check(t, right, sequence(sizes[0], sizes[0]+sizes[1]))
+	}
+}
+
+func TestManySmallConcatenations(t *testing.T) {
+	v := New()
+	for i := 0; i < N; i++ {
+		v = Concatenate(v, L(i))
+	}
+	check(t, v, sequence(0, N))
+
+	v = New()
+	for i := N - 1; i >= 0; i-- {
+		v = Cons(i, v)
+	}
+	check(t, v, sequence(0, N))
+}
+
+func TestSplitAt(t *testing.T) {
+	v := FromSlice(sequence(0, N))
+	for _, i := range []int{-1, 0, 1, 31, 32, 33, 1000, N - 1, N, N + 1} {
+		left, right := SplitAt(v, i)
+		j := min(max(i, 0), N)
+		check(t, left, sequence(0, j))
+		check(t, right, sequence(j, N))
+	}
+	check(t, v, sequence(0, N))
+}
+
+func TestRandomOperations(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	v := FromSlice(sequence(0, N))
+	model := sequence(0, N)
+
+	for k := 0; k < 200; k++ {
+		i := r.Intn(len(model) + 1)
+		j := min(i+r.Intn(100), len(model))
+		v = Concatenate(Slice(v, i, j), v)
+		model = append(append([]lst.Elem{}, model[i:j]...), model...)
+
+		i = r.Intn(len(model) + 1)
+		left, right := SplitAt(v, i)
+		v = Concatenate(right, left)
+		model = append(append([]lst.Elem{}, model[i:]...), model[:i]...)
+	}
+	check(t, v, model)
+}
+
+func TestHeadAndTail(t *testing.T) {
+	v := FromSlice(sequence(0, N))
+	if Head(v) != 0 {
+		t.Errorf("Head returned %v instead of 0", Head(v))
+	}
+	check(t, Tail(v), sequence(1, N))
+}
+
+func TestString(t *testing.T) {
+	if s := L(1, 2, 3).String(); s != "[1, 2, 3]" {
+		t.Errorf("Wrong string representation: %s", s)
+	}
+}
@@ -0,0 +1,99 @@
+package lst
+
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+ * Total counterparts of the functions which panic when given an empty list or
+ * an invalid index. They are meant for code handling input it can't trust.
+ */
+
+var (
+	ErrEmptyList       = errors.New("lst: empty list")
+	ErrIndexOutOfRange = errors.New("lst: index out of range")
+)
+
+// Same as Head, but returns false instead of panicking on an empty list
+func HeadOK(l *List) (Elem, bool) {
+	if Empty(l) {
+		return nil, false
+	}
+	return Head(l), true
+}
+
+// Same as Last, but returns false instead of panicking on an empty list
+func LastOK(l *List) (Elem, bool) {
+	if Empty(l) {
+		return nil, false
+	}
+	return Last(l), true
+}
+
+// Same as Tail, but returns false instead of panicking on an empty list
+func TailOK(l *List) (*List, bool) {
+	if Empty(l) {
+		return nil, false
+	}
+	return Tail(l), true
+}
+
+// Same as Init, but returns false instead of panicking on an empty list
+func InitOK(l *List) (*List, bool) {
+	if Empty(l) {
+		return nil, false
+	}
+	return Init(l), true
+}
+
+// Uncons decomposes a list into its head and its tail. The last item it
+// returns is false if the list is empty.
+//
+// Example:
+//
+// for x, xs, ok := Uncons(l); ok; x, xs, ok = Uncons(xs) {
+// 	do something
+// }
+func Uncons(l *List) (head Elem, tail *List, ok bool) {
+	if Empty(l) {
+		return nil, nil, false
+	}
+	return Head(l), Tail(l), true
+}
+
+// Unsnoc decomposes a list into all but its last element and its last
+// element. The last item it returns is false if the list is empty.
+func Unsnoc(l *List) (init *List, last Elem, ok bool) {
+	if Empty(l) {
+		return nil, nil, false
+	}
+	return Init(l), Last(l), true
+}
+
+// Same as Get, but returns an error wrapping ErrIndexOutOfRange instead of
+// panicking on an invalid index
+func SafeGet(l *List, i int) (Elem, error) {
+	if i < 0 || i >= Len(l) {
+		return nil, fmt.Errorf("%w: index %d, length %d", ErrIndexOutOfRange, i, Len(l))
+	}
+	return Get(l, i), nil
+}
+
+// Same as Foldr1, but returns ErrEmptyList instead of panicking on an empty
+// list
+func Foldr1E(l *List, f func(x Elem, acc interface{}) interface{}) (interface{}, error) {
+	if Empty(l) {
+		return nil, ErrEmptyList
+	}
+	return Foldr1(l, f), nil
+}
+
+// Same as Foldl1, but returns ErrEmptyList instead of panicking on an empty
+// list
+func Foldl1E(l *List, f func(acc interface{}, x Elem) interface{}) (interface{}, error) {
+	if Empty(l) {
+		return nil, ErrEmptyList
+	}
+	return Foldl1(l, f), nil
+}
@@ -0,0 +1,127 @@
+package lst
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestHeadOKAndLastOK(t *testing.T) {
+	if _, ok := HeadOK(New()); ok {
+		t.Error("HeadOK found a head in an empty list")
+	}
+
+	if _, ok := LastOK(New()); ok {
+		t.Error("LastOK found a last element in an empty list")
+	}
+
+	l := NewFromSlice(elements[:])
+	if x, ok := HeadOK(l); !ok || x != elements[0] {
+		t.Errorf("HeadOK returned %v instead of %v", x, elements[0])
+	}
+
+	if x, ok := LastOK(l); !ok || x != elements[N-1] {
+		t.Errorf("LastOK returned %v instead of %v", x, elements[N-1])
+	}
+}
+
+func TestTailOKAndInitOK(t *testing.T) {
+	if _, ok := TailOK(New()); ok {
+		t.Error("TailOK found a tail in an empty list")
+	}
+
+	if _, ok := InitOK(New()); ok {
+		t.Error("InitOK found an init in an empty list")
+	}
+
+	l := L(1, 2, 3)
+	if tail, ok := TailOK(l); !ok || !Equal(tail, L(2, 3)) {
+		t.Errorf("TailOK returned %v instead of [2, 3]", tail)
+	}
+
+	if init, ok := InitOK(l); !ok || !Equal(init, L(1, 2)) {
+		t.Errorf("InitOK returned %v instead of [1, 2]", init)
+	}
+}
+
+func TestUncons(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	i := 0
+	for x, xs, ok := Uncons(l); ok; x, xs, ok = Uncons(xs) {
+		if x != elements[i] {
+			t.Errorf("Mismatched elements at index %d", i)
+		}
+		i++
+	}
+
+	if i != N {
+		t.Errorf("Uncons stopped after %d elements instead of %d", i, N)
+	}
+}
+
+func TestUnsnoc(t *testing.T) {
+	init, last, ok := Unsnoc(L(1, 2, 3))
+	if !ok || last != 3 || !Equal(init, L(1, 2)) {
+		t.Errorf("Unsnoc returned %v and %v", init, last)
+	}
+
+	if _, _, ok := Unsnoc(New()); ok {
+		t.Error("Unsnoc decomposed an empty list")
+	}
+}
+
+func TestSafeGet(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	for _, i := range []int{-1, N, N + 1} {
+		if _, err := SafeGet(l, i); !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("Got error %v for index %d", err, i)
+		}
+	}
+
+	if x, err := SafeGet(l, 3); err != nil || x != elements[3] {
+		t.Errorf("SafeGet returned %v instead of %v", x, elements[3])
+	}
+}
+
+func TestFold1E(t *testing.T) {
+	sum := func(x Elem, acc interface{}) interface{} {
+		return x.(int) + acc.(int)
+	}
+	suml := func(acc interface{}, x Elem) interface{} {
+		return sum(x, acc)
+	}
+
+	if _, err := Foldr1E(New(), sum); err != ErrEmptyList {
+		t.Errorf("Foldr1E returned %v for an empty list", err)
+	}
+
+	if _, err := Foldl1E(New(), suml); err != ErrEmptyList {
+		t.Errorf("Foldl1E returned %v for an empty list", err)
+	}
+
+	if s, err := Foldl1E(L(1, 2, 3), suml); err != nil || s != 6 {
+		t.Errorf("Foldl1E returned %v instead of 6", s)
+	}
+}
+
+func TestEmptyInputs(t *testing.T) {
+	if !And(New()) {
+		t.Error("And of an empty list isn't true")
+	}
+
+	if Or(New()) {
+		t.Error("Or of an empty list isn't false")
+	}
+
+	if !Empty(Flatten(New())) {
+		t.Error("Flatten of an empty list isn't empty")
+	}
+
+	if !Empty(Concatenate()) {
+		t.Error("Concatenation of no lists isn't empty")
+	}
+
+	l := L(1, 2, 3)
+	if !Equal(Delete(4, l), l) {
+		t.Error("Deleting an absent element changed the list")
+	}
+}
@@ -0,0 +1,306 @@
+package lst
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+ * Show and Parse are the inverse of each other: Show writes lists as Go
+ * literals, quoting strings and runes, and Parse reads them back.
+ */
+
+// Show gives the representation of a list with its strings and runes quoted,
+// so that it can be read back by Parse. Floats always have a decimal point or
+// an exponent, in order not to be confused with ints.
+//
+// Example:
+//
+// Show(L("a, b", 'c', 1.0, L(2, nil)))
+// -> ["a, b", 'c', 1.0, [2, nil]]
+func Show(l *List) string {
+	var b strings.Builder
+	writeShow(&b, l)
+	return b.String()
+}
+
+func writeShow(b *strings.Builder, l *List) {
+	b.WriteByte('[')
+	for i := 0; i < Len(l); i++ {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		writeElem(b, Get(l, i))
+	}
+	b.WriteByte(']')
+}
+
+func writeElem(b *strings.Builder, x Elem) {
+	switch x := x.(type) {
+	case nil:
+		b.WriteString("nil")
+	case *List:
+		writeShow(b, x)
+	case Shower:
+		b.WriteString(x.Show())
+	case string:
+		b.WriteString(strconv.Quote(x))
+	case rune:
+		b.WriteString(strconv.QuoteRune(x))
+	case float32:
+		b.WriteString(showFloat(float64(x), 32))
+	case float64:
+		b.WriteString(showFloat(x, 64))
+	default:
+		fmt.Fprintf(b, "%v", x)
+	}
+}
+
+func showFloat(f float64, bitSize int) string {
+	s := strconv.FormatFloat(f, 'g', -1, bitSize)
+	if strings.ContainsAny(s, ".eIN") {
+		return s
+	}
+	return s + ".0"
+}
+
+// ParseError tells where and why Parse failed.
+type ParseError struct {
+	// The byte offset, in the parsed string, where the error was found
+	Pos int
+	Msg string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("lst: parse error at position %d: %s", e.Pos, e.Msg)
+}
+
+// Parse reads a list written by Show. Elements may be nested lists, ints,
+// floats (including +Inf, -Inf and NaN), booleans, quoted strings and runes,
+// and nil.
+//
+// Example:
+//
+// l, err := Parse(`[1, "two", [3.0, '4'], nil]`)
+// -> l = [1, two, [3, 52], <nil>]
+func Parse(s string) (*List, error) {
+	p := &parser{input: s}
+	p.skipSpaces()
+
+	l, err := p.list()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		return nil, p.errorf("unexpected %q after the end of the list", p.input[p.pos:])
+	}
+	return l, nil
+}
+
+type parser struct {
+	input string
+	pos   int
+}
+
+func (p *parser) errorf(format string, args ...interface{}) *ParseError {
+	return &ParseError{p.pos, fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.input) && strings.IndexByte(" \t\n\r", p.input[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+// next gives a description of what comes next in the input, for error
+// messages
+func (p *parser) next() string {
+	if p.pos >= len(p.input) {
+		return "end of input"
+	}
+
+	r, _ := utf8.DecodeRuneInString(p.input[p.pos:])
+	return strconv.QuoteRune(r)
+}
+
+func (p *parser) list() (*List, error) {
+	if p.pos >= len(p.input) || p.input[p.pos] != '[' {
+		return nil, p.errorf("expected '[', found %s", p.next())
+	}
+	p.pos++
+	p.skipSpaces()
+
+	var elems []Elem
+	if p.pos < len(p.input) && p.input[p.pos] == ']' {
+		p.pos++
+		return fromSlice(elems), nil
+	}
+
+	for {
+		x, err := p.elem()
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, x)
+		p.skipSpaces()
+
+		if p.pos >= len(p.input) {
+			return nil, p.errorf("expected ',' or ']', found end of input")
+		}
+
+		switch p.input[p.pos] {
+		case ',':
+			p.pos++
+			p.skipSpaces()
+		case ']':
+			p.pos++
+			return fromSlice(elems), nil
+		default:
+			return nil, p.errorf("expected ',' or ']', found %s", p.next())
+		}
+	}
+}
+
+func (p *parser) elem() (Elem, error) {
+	if p.pos >= len(p.input) {
+		return nil, p.errorf("expected an element, found end of input")
+	}
+
+	switch c := p.input[p.pos]; {
+	case c == '[':
+		return p.list()
+	case c == '"' || c == '`':
+		return p.quoted()
+	case c == '\'':
+		return p.rune()
+	case c == '-' || c == '+' || c == '.' || '0' <= c && c <= '9':
+		return p.number()
+	}
+
+	start := p.pos
+	word := p.word()
+	switch word {
+	case "nil":
+		return nil, nil
+	case "NaN":
+		return math.NaN(), nil
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+
+	p.pos = start
+	return nil, p.errorf("expected an element, found %s", p.next())
+}
+
+// word consumes the letters and digits starting at the current position
+func (p *parser) word() string {
+	start := p.pos
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' || c == '_') {
+			break
+		}
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+// literal consumes a quoted literal, returning it with its quotes
+func (p *parser) literal() (string, error) {
+	start := p.pos
+	quote := p.input[p.pos]
+	p.pos++
+
+	for p.pos < len(p.input) {
+		switch p.input[p.pos] {
+		case quote:
+			p.pos++
+			return p.input[start:p.pos], nil
+		case '\\':
+			if quote != '`' {
+				p.pos++
+			}
+		case '\n':
+			if quote != '`' {
+				return "", p.errorf("newline inside a quoted literal")
+			}
+		}
+		p.pos++
+	}
+
+	p.pos = start
+	return "", p.errorf("unterminated quoted literal")
+}
+
+func (p *parser) quoted() (Elem, error) {
+	start := p.pos
+	lit, err := p.literal()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := strconv.Unquote(lit)
+	if err != nil {
+		p.pos = start
+		return nil, p.errorf("invalid string %s", lit)
+	}
+	return s, nil
+}
+
+func (p *parser) rune() (Elem, error) {
+	start := p.pos
+	lit, err := p.literal()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := strconv.Unquote(lit)
+	if err != nil || utf8.RuneCountInString(s) != 1 {
+		p.pos = start
+		return nil, p.errorf("invalid rune %s", lit)
+	}
+
+	r, _ := utf8.DecodeRuneInString(s)
+	return r, nil
+}
+
+func (p *parser) number() (Elem, error) {
+	start := p.pos
+	if c := p.input[p.pos]; c == '-' || c == '+' {
+		p.pos++
+	}
+
+	if strings.HasPrefix(p.input[p.pos:], "Inf") && p.pos > start {
+		p.pos += len("Inf")
+		return strconv.ParseFloat(p.input[start:p.pos], 64)
+	}
+
+	for p.pos < len(p.input) {
+		c := p.input[p.pos]
+		isExponentSign := (c == '-' || c == '+') && p.pos > start &&
+			(p.input[p.pos-1] == 'e' || p.input[p.pos-1] == 'E')
+		if !('0' <= c && c <= '9' || c == '.' || c == 'e' || c == 'E' || isExponentSign) {
+			break
+		}
+		p.pos++
+	}
+
+	lit := p.input[start:p.pos]
+	if !strings.ContainsAny(lit, ".eE") {
+		if i, err := strconv.Atoi(lit); err == nil {
+			return i, nil
+		}
+	} else if f, err := strconv.ParseFloat(lit, 64); err == nil {
+		return f, nil
+	}
+
+	p.pos = start
+	return nil, p.errorf("invalid number %s", lit)
+}
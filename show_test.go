@@ -0,0 +1,117 @@
+package lst
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestShow(t *testing.T) {
+	cases := []struct {
+		list     *List
+		expected string
+	}{
+		{New(), `[]`},
+		{L("a, b"), `["a, b"]`},
+		{L("a", "b"), `["a", "b"]`},
+		{L('c', "d\"e\n"), `['c', "d\"e\n"]`},
+		{L(1, 1.0, 2.5, 1e100, float32(3)), `[1, 1.0, 2.5, 1e+100, 3.0]`},
+		{L(true, nil, L(L(), L(1))), `[true, nil, [[], [1]]]`},
+		{L(word("go")), `[GO]`},
+	}
+
+	for _, c := range cases {
+		if s := Show(c.list); s != c.expected {
+			t.Errorf("Show gave %s instead of %s", s, c.expected)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected *List
+	}{
+		{`[]`, New()},
+		{` [ 1 ,2 ] `, L(1, 2)},
+		{`[-3, 4.5, -1e-3, 2E3]`, L(-3, 4.5, -1e-3, 2e3)},
+		{`["a, b", 'c', '\n', "é", ` + "`raw\\`" + `]`, L("a, b", 'c', '\n', "é", `raw\`)},
+		{`[true, false, nil]`, L(true, false, nil)},
+		{`[[], [1, [2]]]`, L(New(), L(1, L(2)))},
+	}
+
+	for _, c := range cases {
+		l, err := Parse(c.input)
+		if err != nil {
+			t.Errorf("Parse(%s) failed: %v", c.input, err)
+			continue
+		}
+
+		if !DeepEqual(l, c.expected) {
+			t.Errorf("Parse(%s) gave %v", c.input, l)
+		}
+	}
+}
+
+func TestParseShow(t *testing.T) {
+	l := L(1, -2.0, math.Pi, "[\"x\", 'y']", 'z', nil, false, L("nested", L()))
+	parsed, err := Parse(Show(l))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !DeepEqual(parsed, l) {
+		t.Errorf("Parsing %s gave %s", Show(l), Show(parsed))
+	}
+}
+
+func TestParseShowSpecialFloats(t *testing.T) {
+	l := L(math.Inf(1), math.Inf(-1), math.NaN())
+	if s := Show(l); s != "[+Inf, -Inf, NaN]" {
+		t.Errorf("Show gave %s", s)
+	}
+
+	parsed, err := Parse(Show(l))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if Len(parsed) != 3 || Get(parsed, 0) != math.Inf(1) || Get(parsed, 1) != math.Inf(-1) ||
+		!math.IsNaN(Get(parsed, 2).(float64)) {
+		t.Errorf("Parsing %s gave %s", Show(l), Show(parsed))
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	cases := []struct {
+		input string
+		pos   int
+	}{
+		{``, 0},
+		{`1`, 0},
+		{`[1,`, 3},
+		{`[1 2]`, 3},
+		{`[1, foo]`, 4},
+		{`[1, "abc]`, 4},
+		{`['ab']`, 1},
+		{`[1.2.3]`, 1},
+		{`[1] x`, 4},
+		{`[Inf]`, 1},
+		{`[+Infinity]`, 5},
+		{`[[1], [2]`, 9},
+	}
+
+	for _, c := range cases {
+		_, err := Parse(c.input)
+
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Parse(%s) should have failed, but gave %v", c.input, err)
+			continue
+		}
+
+		if parseErr.Pos != c.pos {
+			t.Errorf("Parse(%s) failed at %d instead of %d: %v", c.input, parseErr.Pos, c.pos, err)
+		}
+	}
+}
@@ -23,8 +23,8 @@ func (s *sortable) Swap(i, j int) {
 }
 
 func (s *sortable) Less(i, j int) bool {
-	// The slice needs to be in reverse order. So, the negation
-	return !s.less(s.elements[i], s.elements[j])
+	// The slice needs to be in reverse order. So, the arguments are swapped
+	return s.less(s.elements[j], s.elements[i])
 }
 
 /*
@@ -39,3 +39,89 @@ func SortBy(l *List, less func(x, y Elem) bool) *List {
 	sort.Sort(s)
 	return newFromReversedSlice(s.elements)
 }
+
+/*
+ * Same as SortBy, but equal elements keep their relative order
+ */
+func SortByStable(l *List, less func(x, y Elem) bool) *List {
+	s := new(sortable)
+	s.elements = make([]Elem, Len(l))
+	copy(s.elements, l.elements)
+	s.less = less
+	sort.Stable(s)
+	return fromElements(s.elements)
+}
+
+/*
+ * Sorts a list of numbers, strings, lists or elements implementing Lesser.
+ * Equal elements keep their relative order.
+ */
+func Sort(l *List) *List {
+	return SortByStable(l, less)
+}
+
+/*
+ * Sorts a list comparing the keys the function “key” gives for its elements,
+ * which must be numbers, strings, lists or implement Lesser. The key of each
+ * element is computed just once, and equal elements keep their relative order.
+ *
+ * Example:
+ *
+ * SortOn(L("ccc", "a", "bb"), func(x Elem) Elem {
+ * 	return len(x.(string))
+ * })
+ * -> [a, bb, ccc]
+ */
+func SortOn(l *List, key func(Elem) Elem) *List {
+	type decorated struct {
+		key, elem Elem
+	}
+
+	d := make([]decorated, Len(l))
+	for i, x := range l.elements {
+		d[i] = decorated{key(x), x}
+	}
+
+	// The slice is in reverse order. So, the arguments are swapped
+	sort.SliceStable(d, func(i, j int) bool {
+		return less(d[j].key, d[i].key)
+	})
+
+	elems := make([]Elem, len(d))
+	for i := range d {
+		elems[i] = d[i].elem
+	}
+	return fromElements(elems)
+}
+
+/*
+ * Tells if a list is sorted according to a function returning true if the “x”
+ * element is lesser then “y”
+ */
+func IsSortedBy(l *List, less func(x, y Elem) bool) bool {
+	for i := 1; i < Len(l); i++ {
+		if less(Get(l, i), Get(l, i-1)) {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+ * Tells if a list of numbers, strings, lists or elements implementing Lesser
+ * is sorted
+ */
+func IsSorted(l *List) bool {
+	return IsSortedBy(l, less)
+}
+
+// Insert inserts x in a sorted list of numbers, strings, lists or elements
+// implementing Lesser, so that the new list remains sorted.
+//
+// Example:
+//
+// Insert(4, L(1, 3, 5, 7))
+// -> [1, 3, 4, 5, 7]
+func Insert(x Elem, l *List) *List {
+	return InsertBy(x, l, less)
+}
@@ -24,3 +24,97 @@ func TestSortBy(t *testing.T) {
 		}
 	}
 }
+
+type entry struct {
+	key, id int
+}
+
+func TestSortByStable(t *testing.T) {
+	l := L(entry{2, 0}, entry{1, 1}, entry{2, 2}, entry{1, 3}, entry{2, 4})
+	sorted := SortByStable(l, func(x, y Elem) bool {
+		return x.(entry).key < y.(entry).key
+	})
+
+	expected := L(entry{1, 1}, entry{1, 3}, entry{2, 0}, entry{2, 2}, entry{2, 4})
+	if !Equal(sorted, expected) {
+		t.Errorf("SortByStable gave %v", sorted)
+	}
+}
+
+func TestSort(t *testing.T) {
+	cases := []struct {
+		list, expected *List
+	}{
+		{L(3, 1, 2), L(1, 2, 3)},
+		{L(2.5, -1.0, 0.0), L(-1.0, 0.0, 2.5)},
+		{L("b", "c", "a"), L("a", "b", "c")},
+		{L(word("B"), word("a"), word("C")), L(word("a"), word("B"), word("C"))},
+		{New(), New()},
+	}
+
+	for _, c := range cases {
+		if sorted := Sort(c.list); !Equal(sorted, c.expected) {
+			t.Errorf("Sort(%v) gave %v", c.list, sorted)
+		}
+	}
+
+	sorted := Sort(NewFromSlice(elements[:]))
+	if !IsSorted(sorted) || Len(sorted) != N {
+		t.Errorf("Sort gave %v", sorted)
+	}
+}
+
+func TestSortOn(t *testing.T) {
+	calls := 0
+	key := func(x Elem) Elem {
+		calls++
+		return len(x.(string))
+	}
+
+	l := L("ccc", "a", "bb", "d")
+	sorted := SortOn(l, key)
+
+	if !Equal(sorted, L("a", "d", "bb", "ccc")) {
+		t.Errorf("SortOn gave %v", sorted)
+	}
+
+	if calls != Len(l) {
+		t.Errorf("The key was computed %d times for %d elements", calls, Len(l))
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	cases := []struct {
+		list   *List
+		sorted bool
+	}{
+		{New(), true},
+		{L(1), true},
+		{L(1, 2, 2, 3), true},
+		{L(1, 3, 2), false},
+		{L("a", "b"), true},
+	}
+
+	for _, c := range cases {
+		if IsSorted(c.list) != c.sorted {
+			t.Errorf("IsSorted(%v) should be %v", c.list, c.sorted)
+		}
+	}
+
+	greater := func(x, y Elem) bool {
+		return x.(int) > y.(int)
+	}
+	if !IsSortedBy(L(3, 2, 1), greater) {
+		t.Error("IsSortedBy should accept a reversed order")
+	}
+}
+
+func TestInsert(t *testing.T) {
+	if i := Insert(4, L(1, 3, 5, 7)); !Equal(i, L(1, 3, 4, 5, 7)) {
+		t.Errorf("Insert gave %v", i)
+	}
+
+	if i := Insert("b", New()); !Equal(i, L("b")) {
+		t.Errorf("Insert gave %v", i)
+	}
+}
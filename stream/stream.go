@@ -0,0 +1,237 @@
+/*
+   Package stream provides lazy, possibly infinite, lists. The tail of a stream
+   is only computed when it is needed, and computed only once, so one can
+   write things like Haskell's "take 10 (iterate f x)":
+
+   	s := stream.Take(stream.Iterate(1, func(x lst.Elem) lst.Elem {
+   		return x.(int) * 2
+   	}), 10)
+
+   	l := stream.Force(s)
+   	-> l = [1, 2, 4, 8, 16, 32, 64, 128, 256, 512]
+
+   The empty stream is represented by a nil *Stream.
+*/
+package stream
+
+import (
+	"iter"
+	"sync"
+
+	"github.com/gustavo-hms/lst"
+)
+
+type Stream struct {
+	head lst.Elem
+	// The tail is computed by thunk the first time it is needed
+	once  sync.Once
+	thunk func() *Stream
+	tail  *Stream
+}
+
+// The stream constructor. It creates a new stream whose head is x and whose
+// tail is lazily given by the function tail.
+//
+// Example:
+//
+// var ones *Stream
+// ones = Cons(1, func() *Stream {
+// 	return ones
+// })
+func Cons(x lst.Elem, tail func() *Stream) *Stream {
+	return &Stream{head: x, thunk: tail}
+}
+
+// Tells if a stream is empty
+func Empty(s *Stream) bool {
+	return s == nil
+}
+
+// Gets the head of the stream
+func Head(s *Stream) lst.Elem {
+	return s.head
+}
+
+// Gets all but the head of the stream, computing it if it's the first time
+// it's asked for
+func Tail(s *Stream) *Stream {
+	s.once.Do(func() {
+		s.tail = s.thunk()
+		s.thunk = nil
+	})
+	return s.tail
+}
+
+// FromList creates a finite stream with the elements of a list.
+func FromList(l *lst.List) *Stream {
+	return fromList(l, 0)
+}
+
+func fromList(l *lst.List, i int) *Stream {
+	if i >= lst.Len(l) {
+		return nil
+	}
+	return Cons(lst.Get(l, i), func() *Stream {
+		return fromList(l, i+1)
+	})
+}
+
+// Iterate creates the infinite stream x, f(x), f(f(x)), ...
+//
+// Example:
+//
+// powers := Iterate(1, func(x lst.Elem) lst.Elem {
+// 	return x.(int) * 2
+// })
+// -> powers = [1, 2, 4, 8, ...
+func Iterate(x lst.Elem, f func(lst.Elem) lst.Elem) *Stream {
+	return Cons(x, func() *Stream {
+		return Iterate(f(x), f)
+	})
+}
+
+// Repeat creates an infinite stream whose elements are all x.
+func Repeat(x lst.Elem) *Stream {
+	var s *Stream
+	s = Cons(x, func() *Stream {
+		return s
+	})
+	return s
+}
+
+// Cycle creates an infinite stream repeating the elements of the list over
+// and over. If the list is empty, so is the stream.
+//
+// Example:
+//
+// Cycle(lst.L(1, 2, 3))
+// -> [1, 2, 3, 1, 2, 3, 1, ...
+func Cycle(l *lst.List) *Stream {
+	if lst.Empty(l) {
+		return nil
+	}
+
+	var first *Stream
+	var cycle func(i int) *Stream
+	cycle = func(i int) *Stream {
+		if i == lst.Len(l) {
+			return first
+		}
+		return Cons(lst.Get(l, i), func() *Stream {
+			return cycle(i + 1)
+		})
+	}
+
+	first = cycle(0)
+	return first
+}
+
+// Unfold builds a stream from a seed. The function f is applied to the seed
+// to obtain the next element and the seed for the rest of the stream; the
+// stream ends when f returns false.
+//
+// Example:
+//
+// countdown := Unfold(3, func(seed interface{}) (lst.Elem, interface{}, bool) {
+// 	n := seed.(int)
+// 	return n, n - 1, n > 0
+// })
+// -> countdown = [3, 2, 1]
+func Unfold(seed interface{}, f func(seed interface{}) (lst.Elem, interface{}, bool)) *Stream {
+	x, next, ok := f(seed)
+	if !ok {
+		return nil
+	}
+	return Cons(x, func() *Stream {
+		return Unfold(next, f)
+	})
+}
+
+// Take creates a stream with, at most, the first n elements of s.
+func Take(s *Stream, n int) *Stream {
+	if n <= 0 || Empty(s) {
+		return nil
+	}
+	return Cons(Head(s), func() *Stream {
+		if n == 1 {
+			// Avoids computing an element that will not be used
+			return nil
+		}
+		return Take(Tail(s), n-1)
+	})
+}
+
+// TakeWhile creates a stream keeping the elements of s while the predicate
+// holds.
+func TakeWhile(s *Stream, f func(lst.Elem) bool) *Stream {
+	if Empty(s) || !f(Head(s)) {
+		return nil
+	}
+	return Cons(Head(s), func() *Stream {
+		return TakeWhile(Tail(s), f)
+	})
+}
+
+// Map creates a stream whose elements are obtained applying the function f to
+// each element of s.
+func Map(s *Stream, f func(lst.Elem) lst.Elem) *Stream {
+	if Empty(s) {
+		return nil
+	}
+	return Cons(f(Head(s)), func() *Stream {
+		return Map(Tail(s), f)
+	})
+}
+
+// Filter creates a stream using only the elements of s satisfying the
+// predicate. Note that looking for the next element of an infinite stream
+// never returns if no remaining element satisfies the predicate.
+func Filter(s *Stream, f func(lst.Elem) bool) *Stream {
+	for !Empty(s) && !f(Head(s)) {
+		s = Tail(s)
+	}
+
+	if Empty(s) {
+		return nil
+	}
+	return Cons(Head(s), func() *Stream {
+		return Filter(Tail(s), f)
+	})
+}
+
+// Zip merges two streams together, like lst.Zip does with lists: each element
+// of the new stream is a list with one element from each of the original
+// streams.
+func Zip(s1, s2 *Stream) *Stream {
+	return ZipWith(s1, s2, func(x, y lst.Elem) lst.Elem {
+		return lst.L(x, y)
+	})
+}
+
+// ZipWith is similar to Zip, but uses the function f to mix the elements of
+// the two streams.
+func ZipWith(s1, s2 *Stream, f func(x, y lst.Elem) lst.Elem) *Stream {
+	if Empty(s1) || Empty(s2) {
+		return nil
+	}
+	return Cons(f(Head(s1), Head(s2)), func() *Stream {
+		return ZipWith(Tail(s1), Tail(s2), f)
+	})
+}
+
+// Values gives an iterator over the elements of the stream.
+func Values(s *Stream) iter.Seq[lst.Elem] {
+	return func(yield func(lst.Elem) bool) {
+		for t := s; !Empty(t); t = Tail(t) {
+			if !yield(Head(t)) {
+				return
+			}
+		}
+	}
+}
+
+// Force computes all the elements of a finite stream and puts them in a list.
+// It never returns for an infinite stream, so use Take or TakeWhile first.
+func Force(s *Stream) *lst.List {
+	return lst.FromSeq(Values(s))
+}
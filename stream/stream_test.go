@@ -0,0 +1,112 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/gustavo-hms/lst"
+)
+
+func double(x lst.Elem) lst.Elem {
+	return x.(int) * 2
+}
+
+func TestIterate(t *testing.T) {
+	l := Force(Take(Iterate(1, double), 10))
+
+	if !lst.Equal(l, lst.L(1, 2, 4, 8, 16, 32, 64, 128, 256, 512)) {
+		t.Errorf("Wrong powers of two: %v", l)
+	}
+}
+
+func TestTakeIsLazy(t *testing.T) {
+	calls := 0
+	s := Iterate(1, func(x lst.Elem) lst.Elem {
+		calls++
+		return x.(int) + 1
+	})
+
+	Force(Take(s, 5))
+	if calls != 4 {
+		t.Errorf("Function called %d times instead of 4", calls)
+	}
+
+	// Tails are memoized
+	Force(Take(s, 5))
+	if calls != 4 {
+		t.Errorf("Tails computed again: %d calls", calls)
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	l := Force(Take(Repeat("a"), 3))
+
+	if !lst.Equal(l, lst.L("a", "a", "a")) {
+		t.Errorf("Wrong repetition: %v", l)
+	}
+}
+
+func TestCycle(t *testing.T) {
+	l := Force(Take(Cycle(lst.L(1, 2, 3)), 7))
+
+	if !lst.Equal(l, lst.L(1, 2, 3, 1, 2, 3, 1)) {
+		t.Errorf("Wrong cycle: %v", l)
+	}
+
+	if !Empty(Cycle(lst.New())) {
+		t.Error("Cycle of an empty list isn't empty")
+	}
+}
+
+func TestUnfold(t *testing.T) {
+	countdown := Unfold(3, func(seed interface{}) (lst.Elem, interface{}, bool) {
+		n := seed.(int)
+		return n, n - 1, n > 0
+	})
+
+	l := Force(countdown)
+	if !lst.Equal(l, lst.L(3, 2, 1)) {
+		t.Errorf("Wrong unfolded list: %v", l)
+	}
+}
+
+func TestMapFilterTakeWhile(t *testing.T) {
+	naturals := Iterate(0, func(x lst.Elem) lst.Elem {
+		return x.(int) + 1
+	})
+
+	even := Filter(naturals, func(x lst.Elem) bool {
+		return x.(int)%2 == 0
+	})
+
+	squares := Map(even, func(x lst.Elem) lst.Elem {
+		return x.(int) * x.(int)
+	})
+
+	small := TakeWhile(squares, func(x lst.Elem) bool {
+		return x.(int) < 100
+	})
+
+	l := Force(small)
+	if !lst.Equal(l, lst.L(0, 4, 16, 36, 64)) {
+		t.Errorf("Wrong stream: %v", l)
+	}
+}
+
+func TestZip(t *testing.T) {
+	zipped := Force(Zip(Iterate(1, double), FromList(lst.L("a", "b"))))
+
+	if zipped.String() != "[[1, a], [2, b]]" {
+		t.Errorf("Wrong zipped stream: %v", zipped)
+	}
+}
+
+func TestFromList(t *testing.T) {
+	l := lst.L(1, 2, 3)
+	if !lst.Equal(Force(FromList(l)), l) {
+		t.Error("Stream differs from its original list")
+	}
+
+	if !lst.Empty(Force(FromList(lst.New()))) {
+		t.Error("Stream of an empty list isn't empty")
+	}
+}
@@ -0,0 +1,38 @@
+package typed
+
+import (
+	"fmt"
+
+	"github.com/gustavo-hms/lst"
+)
+
+// FromList creates a typed list with the elements of an untyped one. It panics
+// if some element of the original list isn't of type T.
+//
+// Example:
+//
+// l := lst.L(1, 2, 3)
+// t := FromList[int](l)
+//
+// -> t = [1, 2, 3]
+func FromList[T any](l *lst.List) *List[T] {
+	slice := make([]T, lst.Len(l))
+	for i := range slice {
+		x, ok := lst.Get(l, i).(T)
+		if !ok {
+			panic(fmt.Sprintf("Element at index %d has unexpected type %T", i, lst.Get(l, i)))
+		}
+		slice[i] = x
+	}
+	return NewFromSlice(slice)
+}
+
+// ToList creates an untyped list, from package lst, with the elements of a
+// typed one.
+func ToList[T any](l *List[T]) *lst.List {
+	slice := make([]lst.Elem, Len(l))
+	for i := range slice {
+		slice[i] = Get(l, i)
+	}
+	return lst.NewFromSlice(slice)
+}
@@ -0,0 +1,38 @@
+package typed
+
+import (
+	"testing"
+
+	"github.com/gustavo-hms/lst"
+)
+
+func TestFromList(t *testing.T) {
+	untyped := lst.NewWithElements(1, 2, 3)
+	l := FromList[int](untyped)
+
+	if !Equal(l, L(1, 2, 3)) {
+		t.Errorf("Converted list is %v instead of [1, 2, 3]", l)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("FromList didn't panic on an element of the wrong type")
+		}
+	}()
+	FromList[string](untyped)
+}
+
+func TestToList(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	untyped := ToList(l)
+
+	if lst.Len(untyped) != N {
+		t.Errorf("Converted list has %d elements instead of %d", lst.Len(untyped), N)
+	}
+
+	for k, v := range elements[:] {
+		if v != lst.Get(untyped, k) {
+			t.Errorf("Mismatched elements at index %d", k)
+		}
+	}
+}
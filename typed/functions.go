@@ -0,0 +1,516 @@
+package typed
+
+import (
+	"fmt"
+)
+
+// Number is satisfied by the types Sum and Prod know how to accumulate
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Pair holds the elements combined by Zip
+type Pair[T, U any] struct {
+	First  T
+	Second U
+}
+
+func (p Pair[T, U]) String() string {
+	return fmt.Sprintf("[%v, %v]", p.First, p.Second)
+}
+
+// Gives the reverse of some list.
+func Reverse[T any](l *List[T]) *List[T] {
+	return Foldl(New[T](), l, func(xs *List[T], x T) *List[T] {
+		return Cons(x, xs)
+	})
+}
+
+// Tells if a list is empty
+func Empty[T any](l *List[T]) bool {
+	return Len(l) <= 0
+}
+
+// Synonym for Empty
+func Null[T any](l *List[T]) bool {
+	return Empty(l)
+}
+
+// Same as Foldr, but uses the last element of the list as the initial value
+func Foldr1[T any](l *List[T], f func(x, acc T) T) T {
+	return Foldr(Last(l), Init(l), f)
+}
+
+// Same as Foldl, but uses the first element of the list as the initial value
+func Foldl1[T any](l *List[T], f func(acc, x T) T) T {
+	return Foldl(Head(l), Tail(l), f)
+}
+
+// Map creates a new list with the same size as the original one, whose
+// elements are obtained applying the function f to each element of the
+// original list.
+//
+// Example:
+//
+// l := NewWithElements(1, 2, 3, 4)
+// plus17 := Map(l, func(x int) int {
+// 	return x + 17
+// })
+//
+// -> plus17 = [18, 19, 20, 21]
+func Map[T, U any](l *List[T], f func(T) U) *List[U] {
+	return Foldr(New[U](), l, func(x T, acc *List[U]) *List[U] {
+		return Cons(f(x), acc)
+	})
+}
+
+// Filter creates a new list using only the elements in the original one that
+// satisfy the given predicate.
+//
+// Example:
+//
+// l := NewWithElements(1, 2, 3, 4)
+// even := Filter(l, func(x int) bool {
+// 	return x%2 == 0
+// })
+//
+// -> even = [2, 4]
+func Filter[T any](l *List[T], f func(T) bool) *List[T] {
+	return Foldr(New[T](), l, func(x T, acc *List[T]) *List[T] {
+		if f(x) {
+			return Cons(x, acc)
+		}
+		return acc
+	})
+}
+
+// Sums all elements of a list of numbers
+func Sum[T Number](l *List[T]) T {
+	return Foldr(0, l, func(x, acc T) T {
+		return acc + x
+	})
+}
+
+// Gives the accumulated product of all elements of a list of numbers
+func Prod[T Number](l *List[T]) T {
+	return Foldr(1, l, func(x, acc T) T {
+		return acc * x
+	})
+}
+
+// Tells if some element belongs to the given list
+func Element[T comparable](x T, l *List[T]) bool {
+	_, found := ElemIndex(x, l)
+	return found
+}
+
+// Tells if some element does not belongs to the given list
+func NotElement[T comparable](x T, l *List[T]) bool {
+	return !Element(x, l)
+}
+
+// ElemIndex returns 2 items. The first one is the index of the first
+// occurrence of the element x in the list l if such an element belongs to the
+// list. The second item it returns is true if the element could be found in
+// the list, or false otherwise.
+func ElemIndex[T comparable](x T, l *List[T]) (int, bool) {
+	for i := 0; i < Len(l); i++ {
+		if x == Get(l, i) {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// ElemIndices returns a list with the indices of all occurrences of the
+// element x in the list xs
+func ElemIndices[T comparable](x T, xs *List[T]) *List[int] {
+	var indices []int
+	for i := 0; i < Len(xs); i++ {
+		if x == Get(xs, i) {
+			indices = append(indices, i)
+		}
+	}
+	return fromSlice(indices)
+}
+
+// Zip merges two lists together, creating a new list where each element is
+// a Pair containing two elements: one from each of the original lists. The
+// length of the new list is equal to the length of the smallest one.
+//
+// Example:
+//
+// l1 := L(1, 2, 3, 4)
+// l2 := L("a", "b", "c")
+// zipped := Zip(l1, l2)
+//
+// -> zipped = [[1, a], [2, b], [3, c]]
+func Zip[T, U any](l1 *List[T], l2 *List[U]) *List[Pair[T, U]] {
+	return ZipWith(l1, l2, func(x T, y U) Pair[T, U] {
+		return Pair[T, U]{x, y}
+	})
+}
+
+// ZipWith is simillar to Zip, but instead of automatically combining the
+// elements of the two original lists creating pairs, it uses the function
+// provided as an argument to mix such elements.
+//
+// Example:
+// l1 := L(1, 2, 3, 4)
+// l2 := L(5, 6, 7)
+// zipped := ZipWith(l1, l2, func(x, y int) int {
+// 	return x * y
+// })
+//
+// -> zipped = [5, 12, 21]
+func ZipWith[T, U, V any](l1 *List[T], l2 *List[U], f func(x T, y U) V) *List[V] {
+	zipped := make([]V, min(Len(l1), Len(l2)))
+	for i := range zipped {
+		zipped[i] = f(Get(l1, i), Get(l2, i))
+	}
+	return fromSlice(zipped)
+}
+
+// TakeWhile creates a new list using the elements of the original one. It will
+// keep the original elements while the predicate given as argument is valid.
+// After that, all elements of the original list are discarded.
+//
+// Example:
+// l1 := L(1, 2, 3, 2, 5, 4, 3, 9, 1)
+// l2 := TakeWhile(l1, func(x int) bool {
+// 	return x < 5
+// })
+//
+// -> l2 = [1, 2, 3, 2]
+func TakeWhile[T any](l *List[T], f func(x T) bool) *List[T] {
+	n := prefixLength(l, f)
+	return view(l, Len(l)-n, Len(l))
+}
+
+// prefixLength gives the length of the longest prefix of l whose elements
+// satisfy the predicate
+func prefixLength[T any](l *List[T], f func(x T) bool) int {
+	i := 0
+	for i < Len(l) && f(Get(l, i)) {
+		i++
+	}
+	return i
+}
+
+// DropWhile, like TakeWhile, creates a new list using the elements of the
+// original one. Unlike TakeWhile, however, it will drop the original elements
+// while the predicate given as argument is valid. The remaining elements are
+// used to create the new list.
+//
+// Example:
+// l1 := L(1, 2, 3, 2, 5, 4, 3, 9, 1)
+// l2 := DropWhile(l1, func(x int) bool {
+// 	return x < 5
+// })
+//
+// -> l2 = [5, 4, 3, 9, 1]
+func DropWhile[T any](l *List[T], f func(x T) bool) *List[T] {
+	n := prefixLength(l, f)
+	return view(l, 0, Len(l)-n)
+}
+
+// Span breaks the original list in two when it finds an element for which the
+// predicate doesn't hold: the first one are the ones it keeps from the
+// original list while the predicate holds; the second one are the remaining
+// elements.
+//
+// Example:
+//
+// l := L(1, 2, 3, 2, 5, 4, 3, 9, 1)
+// l1, l2 := Span(l, func(x int) bool {
+// 	return x < 5
+// })
+//
+// -> l1 = [1, 2, 3, 2]
+//    l2 = [5, 4, 3, 9, 1]
+func Span[T any](l *List[T], f func(x T) bool) (first, rest *List[T]) {
+	n := prefixLength(l, f)
+	return view(l, Len(l)-n, Len(l)), view(l, 0, Len(l)-n)
+}
+
+// Flatten takes a list of lists and transforms it in a flat list.
+//
+// Example:
+//
+// l := L(L(1,2), L(3, 4))
+// -> l = [[1,2], [3,4]]
+// flat := Flatten(l)
+// -> flat = [1, 2, 3, 4]
+func Flatten[T any](l *List[*List[T]]) *List[T] {
+	return Foldr(New[T](), l, func(x, acc *List[T]) *List[T] {
+		return Concatenate(x, acc)
+	})
+}
+
+// Synonym for Flatten
+func Concat[T any](l *List[*List[T]]) *List[T] {
+	return Flatten(l)
+}
+
+// Takes a list of booleans and returns true only if all elements are true (in
+// particular, it returns true for an empty list)
+//
+// Example:
+//
+// l1 := L(true, true, true, true)
+// And(l1)
+// -> true
+//
+// l2 := L(true, true, false, true)
+// And(l2)
+// -> false
+func And(l *List[bool]) bool {
+	return Foldr(true, l, func(x, acc bool) bool {
+		return x && acc
+	})
+}
+
+// Takes a list of booleans and returns true if there are true values in it
+// (so it returns false for an empty list).
+//
+// Example:
+//
+// l1 := L(false, false, false, true)
+// -> true
+//
+// l2 := L(false, false, false, false)
+// -> false
+func Or(l *List[bool]) bool {
+	return Foldr(false, l, func(x, acc bool) bool {
+		return x || acc
+	})
+}
+
+// Returns true if all elements satisfy the predicate. Returns false otherwise.
+//
+// Example:
+//
+// l := L(1, 1, 3, 2, 5)
+// All(l, func(x int) bool {
+// 	return x < 7
+// })
+// -> true
+//
+// All(l, func(x int) bool {
+// 	return x < 5
+// })
+// -> false
+func All[T any](l *List[T], f func(T) bool) bool {
+	return Foldr(true, l, func(x T, acc bool) bool {
+		return f(x) && acc
+	})
+}
+
+// Returns true if any element satisfies the predicate. Returns false
+// otherwise.
+//
+// Example:
+//
+// l := L(1, 1, 3, 2, 5)
+// Any(l, func(x int) bool {
+// 	return x < 3
+// })
+// -> true
+//
+// Any(l, func(x int) bool {
+// 	return x < 1
+// })
+// -> false
+func Any[T any](l *List[T], f func(T) bool) bool {
+	return Foldr(false, l, func(x T, acc bool) bool {
+		return f(x) || acc
+	})
+}
+
+// Groups consecutive identical elements into sublists.
+//
+// Example:
+//
+// l := L(1, 1, 1, 3, 3, 2, 3, 3, 6, 6, 6)
+// Group(l)
+// -> [[1,1,1], [3,3], [2], [3,3], [6,6,6]]
+func Group[T comparable](l *List[T]) *List[*List[T]] {
+	type groups struct {
+		final   *List[*List[T]]
+		sublist *List[T]
+	}
+
+	init := groups{New[*List[T]](), New[T]()}
+	result := Foldr(init, l, func(x T, g groups) groups {
+		switch {
+		case Empty(g.sublist):
+			g.sublist = Cons(x, g.sublist)
+		case x == Head(g.sublist):
+			g.sublist = Cons(x, g.sublist)
+		default:
+			g.final = Cons(g.sublist, g.final)
+			g.sublist = NewWithElements(x)
+		}
+		return g
+	})
+
+	return Cons(result.sublist, result.final)
+}
+
+// Returns two lists. The first one contains all the elements of the original
+// list that satisfy the predicate. The second one contains the remaining one
+// (the ones that do not satisfy the predicate).
+//
+// Example:
+//
+// l := L(1, 1, 1, 3, 3, 2, 3, 3, 6, 6, 6)
+// Partition(l, func(x int) bool {
+// 	x%2 == 0
+// })
+// -> [2, 6, 6, 6] [1, 1, 1, 3, 3, 3, 3]
+func Partition[T any](l *List[T], f func(T) bool) (satisfy, doNot *List[T]) {
+	vec := [2]*List[T]{New[T](), New[T]()} // {satisfy, doNot}
+	result := Foldr(vec, l, func(x T, v [2]*List[T]) [2]*List[T] {
+		if f(x) {
+			v[0] = Cons(x, v[0])
+		} else {
+			v[1] = Cons(x, v[1])
+		}
+		return v
+	})
+
+	return result[0], result[1]
+}
+
+// Unique returns a list with the elements of the original one but without any
+// repetition.
+//
+// Example:
+//
+// l := L(1, 1, 1, 3, 3, 2, 3, 3, 6, 6, 6)
+// Unique(l)
+// -> [1, 3, 2, 6]
+func Unique[T comparable](l *List[T]) *List[T] {
+	table := make(map[T]bool)
+	var uniq []T
+	for x := range Values(l) {
+		if !table[x] {
+			table[x] = true
+			uniq = append(uniq, x)
+		}
+	}
+	return fromSlice(uniq)
+}
+
+// Synonym for Unique
+func Nub[T comparable](l *List[T]) *List[T] {
+	return Unique(l)
+}
+
+// Deletes the first occurrence of an element from a list. If the element isn't
+// found, the list is returned unchanged.
+//
+// Example:
+//
+// l := L(1, 1, 1, 3, 3, 2, 3, 3, 6, 6, 6)
+// Delete(3, l)
+// -> [1, 1, 1, 3, 2, 3, 3, 6, 6, 6]
+func Delete[T comparable](x T, l *List[T]) *List[T] {
+	without, with := Span(l, func(y T) bool {
+		return x != y
+	})
+
+	if Empty(with) {
+		return l
+	}
+	return Concatenate(without, Tail(with))
+}
+
+// Removes, from the first list, the elements found in the second one.
+//
+// Example:
+//
+// l1 := L(1, 2, 2, 3, 4, 4, 5, 6)
+// l2 := L(2, 5, 7, 9, 7, 10)
+// Difference(l1, l2)
+// -> [1, 3, 4, 4, 6]
+func Difference[T comparable](base, subtract *List[T]) *List[T] {
+	table := make(map[T]bool)
+	for _, e := range subtract.elements {
+		table[e] = true
+	}
+	return Foldr(New[T](), base, func(x T, acc *List[T]) *List[T] {
+		if _, ok := table[x]; ok {
+			return acc
+		}
+		return Cons(x, acc)
+	})
+}
+
+// Makes the union of the two lists. Duplicated elements of the second list are
+// removed, as well as elements also found in the first list. However,
+// duplicated elements of the first list aren't removed.
+//
+// Example:
+//
+// l1 := L(1, 2, 2, 3, 4, 4, 5, 6)
+// l2 := L(2, 5, 7, 9, 7, 10)
+// Union(l1, l2)
+// -> [1, 2, 2, 3, 4, 4, 5, 6, 7, 9, 10]
+func Union[T comparable](l1, l2 *List[T]) *List[T] {
+	return Concatenate(l1, Difference(Unique(l2), l1))
+}
+
+// Makes the intersection of the two lists. If the first list contains
+// duplicates, so will the result.
+//
+// Example:
+// l1 := L(1, 2, 2, 3, 4, 4, 5, 6)
+// l2 := L(2, 5, 7, 9, 7, 10)
+// Intersect(l1, l2)
+// -> [2, 2, 5]
+func Intersect[T comparable](l1, l2 *List[T]) *List[T] {
+	table := make(map[T]bool)
+	for _, e := range l2.elements {
+		table[e] = true
+	}
+	return Foldr(New[T](), l1, func(x T, acc *List[T]) *List[T] {
+		if _, ok := table[x]; ok {
+			return Cons(x, acc)
+		}
+		return acc
+	})
+}
+
+// Returns true if the two lists have equal elements.
+func Equal[T comparable](l1, l2 *List[T]) bool {
+	lenL1 := Len(l1)
+	lenL2 := Len(l2)
+	if lenL1 != lenL2 {
+		return false
+	}
+
+	for i := 0; i < lenL1; i++ {
+		if Get(l1, i) != Get(l2, i) {
+			return false
+		}
+	}
+	return true
+}
+
+// Applies the function f to each element in the list, from left to right.
+//
+// Example:
+//
+// l := L(1,2,3)
+// Each(l, func(x int) {
+// 	fmt.Println(x, " ")
+// })
+// -> 1 2 3
+func Each[T any](l *List[T], f func(T)) {
+	for i := 0; i < Len(l); i++ {
+		f(Get(l, i))
+	}
+}
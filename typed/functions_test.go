@@ -0,0 +1,310 @@
+package typed
+
+import (
+	"testing"
+)
+
+func TestReverse(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	r := Reverse(l)
+
+	if Len(r) != Len(l) {
+		t.Error("Reversed list doesn't have the same number of arguments as its original list")
+	}
+
+	for i := 0; i < N; i++ {
+		if Get(r, i) != elements[N-i-1] {
+			t.Errorf("Mismatched elements at index %d", i)
+		}
+	}
+}
+
+func TestMap(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	isEvenList := Map(l, func(x int) bool {
+		return x%2 == 0
+	})
+
+	for k, v := range elements[:] {
+		if (v%2 == 0) != Get(isEvenList, k) {
+			t.Errorf("Mismatched elements at index %d", k)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	evenList := Filter(l, func(x int) bool {
+		return x%2 == 0
+	})
+
+	evenSlice := make([]int, 0, N)
+	for _, v := range elements[:] {
+		if v%2 == 0 {
+			evenSlice = append(evenSlice, v)
+		}
+	}
+
+	if !Equal(evenList, NewFromSlice(evenSlice)) {
+		t.Error("Filter kept the wrong elements")
+	}
+}
+
+func TestSumAndProd(t *testing.T) {
+	if s := Sum(L(1, 2, 3, 4)); s != 10 {
+		t.Errorf("Sum returned %d instead of 10", s)
+	}
+
+	if p := Prod(L(1.5, 2.0, 4.0)); p != 12 {
+		t.Errorf("Prod returned %v instead of 12", p)
+	}
+}
+
+func TestElemIndex(t *testing.T) {
+	l := New[int]()
+	for i := 0; i < N; i++ {
+		l = Cons(1, l)
+	}
+
+	if _, found := ElemIndex(2, l); found {
+		t.Error("Wrongly found element 2 in list")
+	}
+
+	set(l, N-3, 2)
+
+	ind, found := ElemIndex(2, l)
+	if !found {
+		t.Error("Didn't find element 2 in list")
+	}
+	if ind != N-3 {
+		t.Errorf("Element 2 found in wrong position: %d instead of %d", ind, N-3)
+	}
+
+	if !Element(2, l) || NotElement(2, l) {
+		t.Error("Element is inconsistent with ElemIndex")
+	}
+}
+
+func TestElemIndices(t *testing.T) {
+	l := New[int]()
+	for i := 0; i < N; i++ {
+		l = Cons(1, l)
+	}
+
+	set(l, N-10, 2)
+	set(l, N-7, 2)
+	set(l, N-3, 2)
+
+	ind := ElemIndices(2, l)
+
+	if !Equal(ind, L(N-10, N-7, N-3)) {
+		t.Errorf("Found at indices %v instead of [%d, %d, %d]", ind, N-10, N-7, N-3)
+	}
+}
+
+func TestZip(t *testing.T) {
+	l1 := L(1, 2, 3, 4)
+	l2 := L("a", "b", "c")
+
+	zip := Zip(l1, l2)
+
+	if Len(zip) != 3 {
+		t.Errorf("Wrong list's length: %d instead of 3", Len(zip))
+	}
+
+	if zip.String() != "[[1, a], [2, b], [3, c]]" {
+		t.Errorf("Wrong zipped list: %v", zip)
+	}
+}
+
+func TestSpan(t *testing.T) {
+	l := New[int]()
+	for i := 0; i < N; i++ {
+		l = Cons(1, l)
+	}
+
+	set(l, N-10, 2)
+	set(l, N-7, 2)
+	set(l, N-3, 2)
+
+	l1, l2 := Span(l, func(x int) bool {
+		return x != 2
+	})
+
+	if Len(l1) != N-10 {
+		t.Errorf("First list has %d elements instead of %d", Len(l1), N-10)
+	}
+
+	if Len(l2) != 10 {
+		t.Errorf("Second list has %d elements instead of %d", Len(l2), 10)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	l := L(L(1, 2), L(3), L(4, 5))
+	flat := Flatten(l)
+
+	if !Equal(flat, L(1, 2, 3, 4, 5)) {
+		t.Errorf("Flattened list is %v instead of [1, 2, 3, 4, 5]", flat)
+	}
+}
+
+func TestAndOr(t *testing.T) {
+	if !And(L(true, true)) || And(L(true, false)) {
+		t.Error("And returning wrong values")
+	}
+
+	if Or(L(false, false)) || !Or(L(false, true)) {
+		t.Error("Or returning wrong values")
+	}
+}
+
+func TestGroup(t *testing.T) {
+	l := L(1, 1, 1, 3, 3, 2, 3, 3, 6, 6, 6)
+	group := Group(l)
+
+	if group.String() != "[[1, 1, 1], [3, 3], [2], [3, 3], [6, 6, 6]]" {
+		t.Errorf("Wrong groups: %v", group)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	l := L(1, 1, 1, 3, 3, 2, 3, 3, 6, 6, 6)
+	even, odd := Partition(l, func(x int) bool {
+		return x%2 == 0
+	})
+
+	if !Equal(even, L(2, 6, 6, 6)) || !Equal(odd, L(1, 1, 1, 3, 3, 3, 3)) {
+		t.Errorf("Wrong partition: %v and %v", even, odd)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	l1 := L(1, 2, 2, 3, 4, 4, 5, 6)
+	l2 := L(2, 5, 7, 9, 7, 10)
+
+	if u := Unique(l1); !Equal(u, L(1, 2, 3, 4, 5, 6)) {
+		t.Errorf("Wrong unique list: %v", u)
+	}
+
+	if d := Delete(4, l1); !Equal(d, L(1, 2, 2, 3, 4, 5, 6)) {
+		t.Errorf("Wrong list after deletion: %v", d)
+	}
+
+	if d := Difference(l1, l2); !Equal(d, L(1, 3, 4, 4, 6)) {
+		t.Errorf("Wrong difference: %v", d)
+	}
+
+	if u := Union(l1, l2); !Equal(u, L(1, 2, 2, 3, 4, 4, 5, 6, 7, 9, 10)) {
+		t.Errorf("Wrong union: %v", u)
+	}
+
+	if i := Intersect(l1, l2); !Equal(i, L(2, 2, 5)) {
+		t.Errorf("Wrong intersection: %v", i)
+	}
+}
+
+func TestEach(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	plus1 := Map(l, func(x int) int {
+		return x + 1
+	})
+
+	eachPlus1 := New[int]()
+	Each(l, func(x int) {
+		eachPlus1 = Concatenate(eachPlus1, L(x+1))
+	})
+
+	if !Equal(plus1, eachPlus1) {
+		t.Error("Each isn't applying the closure rightly")
+	}
+}
+
+// A list long enough to blow up the stack of recursive implementations
+const longLength = 1000000
+
+func longList() *List[int] {
+	elems := make([]int, longLength)
+	for i := range elems {
+		elems[i] = i
+	}
+	return NewFromSlice(elems)
+}
+
+func TestLongList(t *testing.T) {
+	l := longList()
+
+	if !Element(longLength-1, l) {
+		t.Error("Didn't find the last element")
+	}
+
+	if Element(-1, l) {
+		t.Error("Found an element not in the list")
+	}
+
+	if i, _ := ElemIndex(longLength-1, l); i != longLength-1 {
+		t.Errorf("Last element found at index %d", i)
+	}
+
+	if i := ElemIndices(longLength-1, l); !Equal(i, L(longLength-1)) {
+		t.Errorf("Wrong indices: %v", i)
+	}
+
+	first, rest := Span(l, func(x int) bool {
+		return x < longLength/2
+	})
+	if Len(first) != longLength/2 || Len(rest) != longLength/2 {
+		t.Errorf("Span gave lists with %d and %d elements", Len(first), Len(rest))
+	}
+	if Head(first) != 0 || Head(rest) != longLength/2 {
+		t.Errorf("Span gave lists starting with %d and %d", Head(first), Head(rest))
+	}
+
+	if Len(Zip(l, l)) != longLength || Len(Unique(l)) != longLength {
+		t.Error("Zip or Unique with the wrong length")
+	}
+
+	if Len(Delete(longLength-1, l)) != longLength-1 {
+		t.Error("Delete didn't remove the last element")
+	}
+}
+
+func BenchmarkElement(b *testing.B) {
+	l := longList()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Element(longLength-1, l)
+	}
+}
+
+func BenchmarkZipWith(b *testing.B) {
+	l := longList()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ZipWith(l, l, func(x, y int) int {
+			return x
+		})
+	}
+}
+
+func BenchmarkSpan(b *testing.B) {
+	l := longList()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Span(l, func(x int) bool {
+			return x < longLength/2
+		})
+	}
+}
+
+func BenchmarkUnique(b *testing.B) {
+	l := longList()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Unique(l)
+	}
+}
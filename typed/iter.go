@@ -0,0 +1,56 @@
+package typed
+
+import (
+	"iter"
+)
+
+// Enumerate gives an iterator over the indices and elements of the list, from
+// head to last.
+func Enumerate[T any](l *List[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < Len(l); i++ {
+			if !yield(i, Get(l, i)) {
+				return
+			}
+		}
+	}
+}
+
+// Values gives an iterator over the elements of the list, from head to last.
+func Values[T any](l *List[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < Len(l); i++ {
+			if !yield(Get(l, i)) {
+				return
+			}
+		}
+	}
+}
+
+// Backward gives an iterator over the indices and elements of the list, from
+// last to head.
+func Backward[T any](l *List[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := Len(l) - 1; i >= 0; i-- {
+			if !yield(i, Get(l, i)) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq creates a new list with the elements produced by the iterator, in
+// the same order.
+func FromSeq[T any](seq iter.Seq[T]) *List[T] {
+	var elems []T
+	for x := range seq {
+		elems = append(elems, x)
+	}
+
+	return fromSlice(elems)
+}
+
+// Synonym for FromSeq
+func Collect[T any](seq iter.Seq[T]) *List[T] {
+	return FromSeq(seq)
+}
@@ -0,0 +1,42 @@
+package typed
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestValues(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	if !slices.Equal(slices.Collect(Values(l)), elements[:]) {
+		t.Error("Values yielded elements differing from the original slice")
+	}
+}
+
+func TestEnumerateAndBackward(t *testing.T) {
+	l := L(0, 1, 2)
+	for i, x := range Enumerate(l) {
+		if i != x {
+			t.Errorf("Mismatched elements at index %d", i)
+		}
+	}
+
+	expected := 2
+	for i, x := range Backward(l) {
+		if i != expected || x != expected {
+			t.Errorf("Mismatched elements at index %d", i)
+		}
+		expected--
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	l := FromSeq(slices.Values(elements[:]))
+	if !Equal(l, NewFromSlice(elements[:])) {
+		t.Error("List differs from the original slice")
+	}
+
+	l2 := Cons(0, l)
+	if Len(l2) != N+1 || Head(l2) != 0 || Get(l2, 1) != elements[0] {
+		t.Error("Cons is misbehaving on a list created by FromSeq")
+	}
+}
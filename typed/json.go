@@ -0,0 +1,30 @@
+package typed
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+)
+
+// MarshalJSON encodes the list as a JSON array, from head to last.
+func (l *List[T]) MarshalJSON() ([]byte, error) {
+	// Appending to a non-nil slice, so that empty lists give [] instead of null
+	return json.Marshal(slices.AppendSeq(make([]T, 0, Len(l)), Values(l)))
+}
+
+// UnmarshalJSON decodes a JSON array into the list, decoding each element into
+// a value of type T.
+func (l *List[T]) UnmarshalJSON(data []byte) error {
+	// By convention, null leaves the value unchanged
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var slice []T
+	if err := json.Unmarshal(data, &slice); err != nil {
+		return err
+	}
+
+	*l = *NewFromSlice(slice)
+	return nil
+}
@@ -0,0 +1,32 @@
+package typed
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSON(t *testing.T) {
+	l := L(L(1, 2), New[int](), L(3))
+
+	data, err := json.Marshal(l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `[[1,2],[],[3]]` {
+		t.Errorf("%v was marshalled to %s", l, data)
+	}
+
+	var decoded *List[*List[int]]
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.String() != l.String() {
+		t.Errorf("Unmarshalling gave %v", decoded)
+	}
+
+	if err := json.Unmarshal([]byte(`["a"]`), &decoded); err == nil {
+		t.Error("Unmarshalling strings into lists of lists should fail")
+	}
+}
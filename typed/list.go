@@ -0,0 +1,320 @@
+/*
+   Package typed provides the lists of package lst parameterized by the type of
+   their elements, so that no type assertions are needed when using them.
+
+   To create a new empty list of integers, type:
+
+   	l := typed.New[int]()
+
+   You can also create a list filled with predefined elements:
+
+   	l := typed.NewWithElements(1, 2, 3, 4)
+
+   Or, as a shorthand,
+
+   	l := typed.L(1, 2, 3, 4)
+
+   If you want to use the elements of a slice:
+
+   	l := typed.NewFromSlice(aSlice)
+
+   Lists from package lst can be converted back and forth with FromList and
+   ToList, which allows code to be migrated gradually.
+*/
+package typed
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+type List[T any] struct {
+	elements []T
+	// See Cons function for a better understanding of the following 2 fields
+	firstEmpty *atomic.Int64
+	firstUsed  int
+}
+
+func New[T any]() *List[T] {
+	l := new(List[T])
+	l.elements = make([]T, 0)
+	l.firstEmpty = new(atomic.Int64)
+
+	return l
+}
+
+func NewFromList[T any](original *List[T]) (dest *List[T]) {
+	dest = new(List[T])
+	dest.elements = original.elements
+	dest.firstEmpty = original.firstEmpty
+	dest.firstUsed = original.firstUsed
+	return
+}
+
+func newFromReversedSlice[T any](slice []T) (l *List[T]) {
+	l = new(List[T])
+	l.elements = make([]T, len(slice))
+	copy(l.elements, slice)
+	l.firstEmpty = newCounter(len(slice))
+	return
+}
+
+// fromElements creates a list owning the given vector, which must already be
+// in the reverse order
+func fromElements[T any](elements []T) (l *List[T]) {
+	l = new(List[T])
+	l.elements = elements
+	l.firstEmpty = newCounter(len(elements))
+	return
+}
+
+// fromSlice creates a list owning the given vector, whose elements are in the
+// same order they'll have in the list. The vector is reversed in place.
+func fromSlice[T any](elems []T) *List[T] {
+	for i, j := 0, len(elems)-1; i < j; i, j = i+1, j-1 {
+		elems[i], elems[j] = elems[j], elems[i]
+	}
+	return fromElements(elems)
+}
+
+func NewFromSlice[T any](slice []T) (l *List[T]) {
+	l = new(List[T])
+	l.elements = make([]T, len(slice))
+
+	for i, v := range slice {
+		set(l, i, v)
+	}
+
+	l.firstEmpty = newCounter(len(slice))
+	return
+}
+
+func NewWithElements[T any](elems ...T) (l *List[T]) {
+	return NewFromSlice(elems)
+}
+
+// Just for convenience
+func L[T any](elems ...T) *List[T] {
+	return NewWithElements(elems...)
+}
+
+// newCounter creates the firstEmpty counter of a newly allocated vector
+func newCounter(firstEmpty int) *atomic.Int64 {
+	counter := new(atomic.Int64)
+	counter.Store(int64(firstEmpty))
+	return counter
+}
+
+func Len[T any](l *List[T]) int {
+	return len(l.elements)
+}
+
+func (l *List[T]) String() string {
+	// The elements are stored in reverse order in the elements slice
+	last := Len(l) - 1
+	elems := make([]string, last+1)
+
+	for k, v := range l.elements {
+		elems[last-k] = fmt.Sprintf("%v", v)
+	}
+
+	return "[" + strings.Join(elems, ", ") + "]"
+}
+
+func Get[T any](l *List[T], i int) T {
+	last := Len(l) - 1
+	return l.elements[last-i]
+}
+
+func set[T any](l *List[T], i int, x T) {
+	last := Len(l) - 1
+	l.elements[last-i] = x
+}
+
+// MakeIterator creates a function one can use to iterate over the elements of
+// the list. Unlike lst.MakeIterator, the end of the loop is signalised by its
+// second return value, so zero values in the list are not a problem.
+//
+// Example:
+//
+// next := MakeIterator(list)
+// for element, ok := next(); ok; element, ok = next() {
+// 	do something
+// }
+func MakeIterator[T any](l *List[T]) func() (T, bool) {
+	index := -1
+	return func() (elem T, ok bool) {
+		index++
+		if index > Len(l)-1 {
+			return
+		}
+		return Get(l, index), true
+	}
+}
+
+// MakeReverseIterator creates a function one can use to iterate over the
+// elements of the list in the reverse order. The end of the loop is
+// signalised by its second return value.
+//
+// Example:
+//
+// previous := MakeReverseIterator(list)
+// for element, ok := previous(); ok; element, ok = previous() {
+// 	do something
+// }
+func MakeReverseIterator[T any](l *List[T]) func() (T, bool) {
+	index := Len(l)
+	return func() (elem T, ok bool) {
+		index--
+		if index < 0 {
+			return
+		}
+		return Get(l, index), true
+	}
+}
+
+// Gets the head of the list (its most recently inserted element)
+func Head[T any](l *List[T]) T {
+	return Get(l, 0)
+}
+
+// Gets all but the head of the list
+func Tail[T any](l *List[T]) (tailList *List[T]) {
+	tailList = new(List[T])
+	tailList.elements = l.elements[:Len(l)-1]
+	tailList.firstEmpty = l.firstEmpty
+	tailList.firstUsed = l.firstUsed
+	return
+}
+
+// Gets the last element of the list (the first inserted element)
+func Last[T any](l *List[T]) T {
+	return Get(l, Len(l)-1)
+}
+
+// Gets all but the last element of the list
+func Init[T any](l *List[T]) (initList *List[T]) {
+	initList = new(List[T])
+	initList.elements = l.elements[1:]
+	initList.firstEmpty = l.firstEmpty
+	initList.firstUsed = l.firstUsed + 1
+	return
+}
+
+// view creates a list sharing the vector of l, using only the elements from
+// start (inclusive) to end (exclusive) of its reversed slice
+func view[T any](l *List[T], start, end int) (v *List[T]) {
+	v = new(List[T])
+	v.elements = l.elements[start:end]
+	v.firstEmpty = l.firstEmpty
+	v.firstUsed = l.firstUsed + start
+	return
+}
+
+// The list constructor. It constructs a new list by inserting a new element in
+// the front of an old one.
+//
+// Example:
+//
+// first := New[int]()
+//
+// second := Cons(1, first)
+//
+// third := Cons(2, second)
+func Cons[T any](x T, l *List[T]) (newl *List[T]) {
+	/*
+	 * The vector storing the elements may be shared by several lists, possibly
+	 * in different goroutines, so we must not overwrite an element inserted by
+	 * another list sharing it. The position just after the head of the list
+	 * may only be used by whoever manages to atomically advance firstEmpty,
+	 * exactly as in lst.Cons.
+	 */
+	length := Len(l)
+	slot := int64(l.firstUsed + length)
+	if length < cap(l.elements) && l.firstEmpty.CompareAndSwap(slot, slot+1) {
+		newl = NewFromList(l)
+		newl.elements = l.elements[:length+1]
+		newl.elements[length] = x
+		return
+	}
+
+	// The desired position is already taken or doesn't exist. We need a copy
+	// then, and the new vector gets its own firstEmpty
+	newl = new(List[T])
+	newl.elements = append(l.elements[:length:length], x)
+	newl.firstEmpty = newCounter(length + 1)
+	return
+}
+
+// Foldr makes a fold in the list from right to left. For each element, it
+// applies the function f given in its third argument as f(e, acc), where e is
+// the current element in the list, and acc is the value returned by f in the
+// previous iteration. In its first iteration, it uses the value in "init" as
+// the value for "acc".
+//
+// Example:
+//
+// l := NewWithElements(1, 2, 3, 4)
+//
+// sum := Foldr(0, l, func(x, acc int) int {
+// 	return x + acc
+// })
+func Foldr[T, A any](init A, l *List[T], f func(T, A) A) (accum A) {
+	accum = init
+	for _, v := range l.elements {
+		accum = f(v, accum)
+	}
+	return
+}
+
+// Similar to Foldr, Foldl makes a fold in the list left to right. For each
+// element, it applies the function f given in its third argument as f(acc, e),
+// where e is the current element in the list, and acc is the value returned by
+// f in the previous iteration. In its first iteration, it uses the value in
+// "init" as the value for "acc".
+//
+// Example:
+//
+// l := NewWithElements(1, 2, 3, 4)
+//
+// sum := Foldl(1, l, func(acc, x int) int {
+// 	return x + acc
+// })
+//
+// -> sum = 11
+func Foldl[T, A any](init A, l *List[T], f func(A, T) A) (accum A) {
+	accum = init
+	for i := 0; i < Len(l); i++ {
+		accum = f(accum, Get(l, i))
+	}
+	return
+}
+
+func concatenate[T any](l1, l2 *List[T]) *List[T] {
+	return Foldr(l2, l1, Cons[T])
+}
+
+// Concatenates all the lists given as arguments. Without arguments, it gives
+// an empty list.
+//
+// Example:
+//
+// l1 := NewWithElements(1, 2)
+// l2 := NewWithElements(3, 4)
+// l3 := NewWithElements(5, 6)
+// c := Concatenate(l1, l2, l3)
+//
+// -> c = [1, 2, 3, 4, 5, 6]
+func Concatenate[T any](lists ...*List[T]) (con *List[T]) {
+	if len(lists) == 0 {
+		return New[T]()
+	}
+
+	last := len(lists) - 1
+	con = lists[last]
+	for i := last - 1; i >= 0; i-- {
+		con = concatenate(lists[i], con)
+	}
+	return
+}
@@ -0,0 +1,229 @@
+package typed
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"testing"
+)
+
+const N = 100
+
+var elements [N]int
+
+func init() {
+	for i := 0; i < N; i++ {
+		elements[i] = rand.Int()
+	}
+}
+
+func TestNewFromSlice(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	for k, v := range elements {
+		if v != l.elements[N-k-1] {
+			t.Error("Some list's elements differ from the elements in slice")
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	for k, v := range elements {
+		if v != Get(l, k) {
+			t.Error("Some list's elements differ from the elements in slice")
+		}
+	}
+}
+
+func TestLen(t *testing.T) {
+	l := New[int]()
+	if Len(l) != 0 {
+		t.Errorf("Function Len reported a length of %d instead of 0", Len(l))
+	}
+
+	l = NewFromSlice(elements[:])
+	if Len(l) != N {
+		t.Errorf("Function Len reported a length of %d instead of %d", Len(l), N)
+	}
+}
+
+func TestString(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	var elementsAsString [N]string
+	for k, v := range elements[:] {
+		elementsAsString[k] = fmt.Sprintf("%v", v)
+	}
+
+	desired := "[" + strings.Join(elementsAsString[:], ", ") + "]"
+
+	if desired != l.String() {
+		t.Error("Wrong string representation")
+	}
+}
+
+func TestMakeIterator(t *testing.T) {
+	l := L(1, 0, 2)
+	it := MakeIterator(l)
+
+	for k, v := range []int{1, 0, 2} {
+		elem, ok := it()
+		if !ok || v != elem {
+			t.Errorf("Mismatched elements at index %d", k)
+		}
+	}
+
+	if _, ok := it(); ok {
+		t.Error("Iterator didn't finish")
+	}
+}
+
+func TestMakeReverseIterator(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	it := MakeReverseIterator(l)
+
+	for i := N - 1; i >= 0; i-- {
+		if elem, _ := it(); elements[i] != elem {
+			t.Errorf("Mismatched elements at index %d", i)
+		}
+	}
+
+	if _, ok := it(); ok {
+		t.Error("Iterator didn't finish")
+	}
+}
+
+func TestHeadAndTail(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	if Head(l) != elements[0] {
+		t.Errorf("Head returned a value of %d, but first element of array is %d", Head(l), elements[0])
+	}
+
+	tail := Tail(l)
+	for k, v := range elements[1:] {
+		if v != Get(tail, k) {
+			t.Errorf("Mismatched elements at index %d", k)
+		}
+	}
+}
+
+func TestLastAndInit(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	if Last(l) != elements[N-1] {
+		t.Errorf("Last returned a value of %d, but last element of array is %d", Last(l), elements[N-1])
+	}
+
+	init := Init(l)
+	for k, v := range elements[:N-1] {
+		if v != Get(init, k) {
+			t.Errorf("Mismatched elements at index %d", k)
+		}
+	}
+}
+
+func TestCons(t *testing.T) {
+	l := New[int]()
+	for _, v := range elements {
+		l = Cons(v, l)
+	}
+
+	if Len(l) != N {
+		t.Errorf("Number of elements (%d) differ from the %d elements expected", Len(l), N)
+	}
+
+	it := MakeReverseIterator(l)
+	i := 0
+	for elem, ok := it(); ok; elem, ok = it() {
+		if elem != elements[i] {
+			t.Errorf("Mismatched elements at index %d", N-i-1)
+		}
+		i++
+	}
+
+	l2 := Cons(Head(l)-1, Tail(l))
+	if Head(l) == Head(l2) {
+		t.Error("Cons is overwritting elements in lists")
+	}
+}
+
+func TestFoldr(t *testing.T) {
+	l := NewFromSlice(elements[:])
+
+	listSum := Foldr(0, l, func(e, accum int) int {
+		return e + accum/2
+	})
+
+	sliceSum := 0
+	for i := N - 1; i >= 0; i-- {
+		sliceSum = elements[i] + sliceSum/2
+	}
+
+	if listSum != sliceSum {
+		t.Errorf("Got different sums: %d and %d", listSum, sliceSum)
+	}
+}
+
+func TestFoldl(t *testing.T) {
+	l := NewFromSlice(elements[:])
+
+	listSum := Foldl(0, l, func(accum, e int) int {
+		return e + accum/2
+	})
+
+	sliceSum := 0
+	for _, v := range elements[:] {
+		sliceSum = v + sliceSum/2
+	}
+
+	if listSum != sliceSum {
+		t.Errorf("Got different sums: %d and %d", listSum, sliceSum)
+	}
+}
+
+func TestConcatenate(t *testing.T) {
+	l1 := NewFromSlice(elements[0 : N/3])
+	l2 := NewFromSlice(elements[N/3 : (2*N)/3])
+	l3 := NewFromSlice(elements[(2*N)/3 : N])
+
+	conc := Concatenate(l1, l2, l3)
+
+	if Len(conc) != N {
+		t.Errorf("Concatenated list has a length of %d, but %d was expected", Len(conc), N)
+	}
+
+	for k, v := range elements[:] {
+		if v != Get(conc, k) {
+			t.Errorf("Mismatched elements at index %d", k)
+		}
+	}
+}
+
+func TestConsConcurrently(t *testing.T) {
+	const goroutines = 8
+
+	base := New[int]()
+	for i := 0; i < N; i++ {
+		base = Cons(i, base)
+	}
+
+	var wg sync.WaitGroup
+	results := make([][]*List[int], goroutines)
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < N; i++ {
+				results[g] = append(results[g], Cons(g, base), Cons(g, Init(base)))
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	for g, lists := range results {
+		for _, l := range lists {
+			if Head(l) != g {
+				t.Fatalf("Element inserted by goroutine %d was overwritten by %d", g, Head(l))
+			}
+		}
+	}
+}
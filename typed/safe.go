@@ -0,0 +1,100 @@
+package typed
+
+import (
+	"fmt"
+
+	"github.com/gustavo-hms/lst"
+)
+
+/*
+ * Total counterparts of the functions which panic when given an empty list or
+ * an invalid index. They report errors with the same values as package lst.
+ */
+
+// Same as Head, but returns false instead of panicking on an empty list
+func HeadOK[T any](l *List[T]) (T, bool) {
+	if Empty(l) {
+		var zero T
+		return zero, false
+	}
+	return Head(l), true
+}
+
+// Same as Last, but returns false instead of panicking on an empty list
+func LastOK[T any](l *List[T]) (T, bool) {
+	if Empty(l) {
+		var zero T
+		return zero, false
+	}
+	return Last(l), true
+}
+
+// Same as Tail, but returns false instead of panicking on an empty list
+func TailOK[T any](l *List[T]) (*List[T], bool) {
+	if Empty(l) {
+		return nil, false
+	}
+	return Tail(l), true
+}
+
+// Same as Init, but returns false instead of panicking on an empty list
+func InitOK[T any](l *List[T]) (*List[T], bool) {
+	if Empty(l) {
+		return nil, false
+	}
+	return Init(l), true
+}
+
+// Uncons decomposes a list into its head and its tail. The last item it
+// returns is false if the list is empty.
+//
+// Example:
+//
+// for x, xs, ok := Uncons(l); ok; x, xs, ok = Uncons(xs) {
+// 	do something
+// }
+func Uncons[T any](l *List[T]) (head T, tail *List[T], ok bool) {
+	if Empty(l) {
+		return head, nil, false
+	}
+	return Head(l), Tail(l), true
+}
+
+// Unsnoc decomposes a list into all but its last element and its last
+// element. The last item it returns is false if the list is empty.
+func Unsnoc[T any](l *List[T]) (init *List[T], last T, ok bool) {
+	if Empty(l) {
+		return nil, last, false
+	}
+	return Init(l), Last(l), true
+}
+
+// Same as Get, but returns an error wrapping lst.ErrIndexOutOfRange instead of
+// panicking on an invalid index
+func SafeGet[T any](l *List[T], i int) (T, error) {
+	if i < 0 || i >= Len(l) {
+		var zero T
+		return zero, fmt.Errorf("%w: index %d, length %d", lst.ErrIndexOutOfRange, i, Len(l))
+	}
+	return Get(l, i), nil
+}
+
+// Same as Foldr1, but returns lst.ErrEmptyList instead of panicking on an
+// empty list
+func Foldr1E[T any](l *List[T], f func(x, acc T) T) (T, error) {
+	if Empty(l) {
+		var zero T
+		return zero, lst.ErrEmptyList
+	}
+	return Foldr1(l, f), nil
+}
+
+// Same as Foldl1, but returns lst.ErrEmptyList instead of panicking on an
+// empty list
+func Foldl1E[T any](l *List[T], f func(acc, x T) T) (T, error) {
+	if Empty(l) {
+		var zero T
+		return zero, lst.ErrEmptyList
+	}
+	return Foldl1(l, f), nil
+}
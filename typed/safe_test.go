@@ -0,0 +1,118 @@
+package typed
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gustavo-hms/lst"
+)
+
+func TestOKFunctions(t *testing.T) {
+	empty := New[int]()
+
+	if _, ok := HeadOK(empty); ok {
+		t.Error("HeadOK found a head in an empty list")
+	}
+
+	if _, ok := LastOK(empty); ok {
+		t.Error("LastOK found a last element in an empty list")
+	}
+
+	if _, ok := TailOK(empty); ok {
+		t.Error("TailOK found a tail in an empty list")
+	}
+
+	if _, ok := InitOK(empty); ok {
+		t.Error("InitOK found an init in an empty list")
+	}
+
+	l := L(1, 2, 3)
+	if x, ok := HeadOK(l); !ok || x != 1 {
+		t.Errorf("HeadOK returned %v", x)
+	}
+
+	if x, ok := LastOK(l); !ok || x != 3 {
+		t.Errorf("LastOK returned %v", x)
+	}
+
+	if tail, ok := TailOK(l); !ok || !Equal(tail, L(2, 3)) {
+		t.Errorf("TailOK returned %v", tail)
+	}
+
+	if init, ok := InitOK(l); !ok || !Equal(init, L(1, 2)) {
+		t.Errorf("InitOK returned %v", init)
+	}
+}
+
+func TestUnconsAndUnsnoc(t *testing.T) {
+	sum := 0
+	for x, xs, ok := Uncons(L(1, 2, 3)); ok; x, xs, ok = Uncons(xs) {
+		sum += x
+	}
+
+	if sum != 6 {
+		t.Errorf("Uncons visited elements summing %d", sum)
+	}
+
+	if init, last, ok := Unsnoc(L(1, 2, 3)); !ok || last != 3 || !Equal(init, L(1, 2)) {
+		t.Errorf("Unsnoc returned %v and %v", init, last)
+	}
+
+	if _, _, ok := Unsnoc(New[int]()); ok {
+		t.Error("Unsnoc decomposed an empty list")
+	}
+}
+
+func TestSafeGetAndFolds(t *testing.T) {
+	l := L(1, 2, 3)
+	if x, err := SafeGet(l, 2); err != nil || x != 3 {
+		t.Errorf("SafeGet returned %v, %v", x, err)
+	}
+
+	if _, err := SafeGet(l, 3); !errors.Is(err, lst.ErrIndexOutOfRange) {
+		t.Errorf("SafeGet returned the error %v", err)
+	}
+
+	sub := func(x, y int) int {
+		return x - y
+	}
+
+	if x, err := Foldr1E(l, sub); err != nil || x != 2 {
+		t.Errorf("Foldr1E returned %v, %v", x, err)
+	}
+
+	if x, err := Foldl1E(l, sub); err != nil || x != -4 {
+		t.Errorf("Foldl1E returned %v, %v", x, err)
+	}
+
+	if _, err := Foldr1E(New[int](), sub); !errors.Is(err, lst.ErrEmptyList) {
+		t.Errorf("Foldr1E returned the error %v", err)
+	}
+
+	if _, err := Foldl1E(New[int](), sub); !errors.Is(err, lst.ErrEmptyList) {
+		t.Errorf("Foldl1E returned the error %v", err)
+	}
+}
+
+func TestTotalFunctions(t *testing.T) {
+	if c := Concatenate[int](); !Empty(c) {
+		t.Errorf("Concatenate without arguments returned %v", c)
+	}
+
+	if !And(New[bool]()) || Or(New[bool]()) {
+		t.Error("And and Or returned wrong values for an empty list")
+	}
+
+	if f := Flatten(New[*List[int]]()); !Empty(f) {
+		t.Errorf("Flattening an empty list returned %v", f)
+	}
+
+	l := L(1, 2, 3)
+	if d := Delete(4, l); d != l {
+		t.Errorf("Deleting an absent element returned %v", d)
+	}
+
+	if d := Delete(2, l); !Equal(d, L(1, 3)) {
+		t.Errorf("Delete returned %v", d)
+	}
+}
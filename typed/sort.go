@@ -0,0 +1,41 @@
+package typed
+
+import (
+	"sort"
+)
+
+/*
+ * To use package sort, one needs a structure conforming its interface. The Len
+ * and Swap methods are trivial, but Less needs to be given by the user
+ */
+
+type sortable[T any] struct {
+	elements []T
+	less     func(T, T) bool
+}
+
+func (s *sortable[T]) Len() int {
+	return len(s.elements)
+}
+
+func (s *sortable[T]) Swap(i, j int) {
+	s.elements[i], s.elements[j] = s.elements[j], s.elements[i]
+}
+
+func (s *sortable[T]) Less(i, j int) bool {
+	// The slice needs to be in reverse order. So, the negation
+	return !s.less(s.elements[i], s.elements[j])
+}
+
+/*
+ * Sorts a list based on a function returning true if the “x” element is lesser
+ * then “y”
+ */
+func SortBy[T any](l *List[T], less func(x, y T) bool) *List[T] {
+	s := new(sortable[T])
+	s.elements = make([]T, Len(l))
+	copy(s.elements, l.elements)
+	s.less = less
+	sort.Sort(s)
+	return newFromReversedSlice(s.elements)
+}
@@ -0,0 +1,24 @@
+package typed
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestSortBy(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	sortedList := SortBy(l, func(x, y int) bool {
+		return x < y
+	})
+
+	slice := make([]int, N)
+	copy(slice, elements[:])
+	sort.Ints(slice)
+
+	for k, v := range slice {
+		if v != Get(sortedList, k) {
+			t.Errorf("Wrong order at index %d", k)
+			break
+		}
+	}
+}
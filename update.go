@@ -0,0 +1,121 @@
+package lst
+
+import (
+	"fmt"
+)
+
+/*
+ * Persistent versions of indexed updates. A list can only share the vector of
+ * another one when it's obtained by inserting elements at its front (see
+ * Cons), or by dropping elements of its ends (see Tail and Init), so the
+ * operations below reuse these functions whenever they can. Otherwise, they
+ * copy the elements to a new vector just once.
+ */
+
+func checkIndex(l *List, i, length int) {
+	if i < 0 || i >= length {
+		panic(fmt.Sprintf("Index %d out of range for a list of length %d", i, Len(l)))
+	}
+}
+
+// Update creates a new list replacing the i-th element of the original one by
+// x.
+//
+// Example:
+//
+// l := L(1, 2, 3)
+// Update(l, 1, 7)
+// -> [1, 7, 3]
+func Update(l *List, i int, x Elem) *List {
+	checkIndex(l, i, Len(l))
+	if i == 0 {
+		return Cons(x, Tail(l))
+	}
+
+	elems := make([]Elem, Len(l))
+	copy(elems, l.elements)
+	elems[Len(l)-1-i] = x
+	return fromElements(elems)
+}
+
+// Adjust creates a new list replacing the i-th element of the original one by
+// the result of applying the function f to it.
+//
+// Example:
+//
+// l := L(1, 2, 3)
+// Adjust(l, 1, func(x Elem) Elem {
+// 	return x.(int) * 10
+// })
+// -> [1, 20, 3]
+func Adjust(l *List, i int, f func(Elem) Elem) *List {
+	checkIndex(l, i, Len(l))
+	return Update(l, i, f(Get(l, i)))
+}
+
+// InsertAt creates a new list inserting x in the original one so that it
+// becomes its i-th element. The index i may be equal to the length of the list,
+// in which case x becomes its last element.
+//
+// Example:
+//
+// l := L(1, 2, 3)
+// InsertAt(l, 1, 7)
+// -> [1, 7, 2, 3]
+func InsertAt(l *List, i int, x Elem) *List {
+	checkIndex(l, i, Len(l)+1)
+	if i == 0 {
+		return Cons(x, l)
+	}
+
+	// Position of the new element in the reversed vector
+	k := Len(l) - i
+	elems := make([]Elem, Len(l)+1)
+	copy(elems, l.elements[:k])
+	elems[k] = x
+	copy(elems[k+1:], l.elements[k:])
+	return fromElements(elems)
+}
+
+// DeleteAt creates a new list without the i-th element of the original one.
+//
+// Example:
+//
+// l := L(1, 2, 3)
+// DeleteAt(l, 1)
+// -> [1, 3]
+func DeleteAt(l *List, i int) *List {
+	checkIndex(l, i, Len(l))
+	switch i {
+	case 0:
+		return Tail(l)
+	case Len(l) - 1:
+		return Init(l)
+	}
+
+	// Position of the deleted element in the reversed vector
+	k := Len(l) - 1 - i
+	elems := make([]Elem, Len(l)-1)
+	copy(elems, l.elements[:k])
+	copy(elems[k:], l.elements[k+1:])
+	return fromElements(elems)
+}
+
+// Swap creates a new list exchanging the i-th and the j-th elements of the
+// original one.
+//
+// Example:
+//
+// l := L(1, 2, 3)
+// Swap(l, 0, 2)
+// -> [3, 2, 1]
+func Swap(l *List, i, j int) *List {
+	checkIndex(l, i, Len(l))
+	checkIndex(l, j, Len(l))
+
+	elems := make([]Elem, Len(l))
+	copy(elems, l.elements)
+	last := Len(l) - 1
+	elems[last-i], elems[last-j] = elems[last-j], elems[last-i]
+	return fromElements(elems)
+}
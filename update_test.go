@@ -0,0 +1,120 @@
+package lst
+
+import (
+	"testing"
+)
+
+func TestUpdate(t *testing.T) {
+	l := NewFromSlice(elements[:])
+	for _, i := range []int{0, 1, N / 2, N - 1} {
+		updated := Update(l, i, -1)
+
+		for k, v := range elements {
+			expected := v
+			if k == i {
+				expected = -1
+			}
+			if Get(updated, k) != expected {
+				t.Errorf("Mismatched elements at index %d after updating index %d", k, i)
+			}
+		}
+	}
+
+	if !Equal(l, NewFromSlice(elements[:])) {
+		t.Error("Update modified the original list")
+	}
+}
+
+func TestUpdateHead(t *testing.T) {
+	l := Cons(0, L(1, 2, 3))
+	used := l.firstEmpty.Load()
+
+	// The slot after the tail holds the head of l, so Cons can't take it and
+	// copies the tail instead of overwriting the vector of l
+	updated := Update(l, 0, 9)
+	if !Equal(updated, L(9, 1, 2, 3)) || !Equal(l, L(0, 1, 2, 3)) {
+		t.Errorf("Update gave %v, changing the original list to %v", updated, l)
+	}
+
+	if updated.firstEmpty == l.firstEmpty || l.firstEmpty.Load() != used {
+		t.Error("Update claimed a slot of the vector of the original list")
+	}
+
+	// The new vector is shared by the lists consed to the updated one
+	if c := Cons(8, updated); c.firstEmpty != updated.firstEmpty {
+		t.Error("Consing to the updated list copied its vector")
+	}
+}
+
+func TestAdjust(t *testing.T) {
+	l := L(1, 2, 3)
+	adjusted := Adjust(l, 1, func(x Elem) Elem {
+		return x.(int) * 10
+	})
+
+	if !Equal(adjusted, L(1, 20, 3)) {
+		t.Errorf("Adjust returned %v instead of [1, 20, 3]", adjusted)
+	}
+}
+
+func TestInsertAt(t *testing.T) {
+	l := L(1, 2, 3)
+	cases := []struct {
+		index    int
+		expected *List
+	}{
+		{0, L(7, 1, 2, 3)},
+		{1, L(1, 7, 2, 3)},
+		{3, L(1, 2, 3, 7)},
+	}
+
+	for _, c := range cases {
+		if inserted := InsertAt(l, c.index, 7); !Equal(inserted, c.expected) {
+			t.Errorf("InsertAt(%d) returned %v instead of %v", c.index, inserted, c.expected)
+		}
+	}
+
+	if !Equal(l, L(1, 2, 3)) {
+		t.Error("InsertAt modified the original list")
+	}
+}
+
+func TestDeleteAt(t *testing.T) {
+	l := L(1, 2, 3, 4)
+	cases := []struct {
+		index    int
+		expected *List
+	}{
+		{0, L(2, 3, 4)},
+		{2, L(1, 2, 4)},
+		{3, L(1, 2, 3)},
+	}
+
+	for _, c := range cases {
+		if deleted := DeleteAt(l, c.index); !Equal(deleted, c.expected) {
+			t.Errorf("DeleteAt(%d) returned %v instead of %v", c.index, deleted, c.expected)
+		}
+	}
+
+	// The result must be usable with Cons without disturbing the original
+	deleted := Cons(0, DeleteAt(l, 2))
+	if !Equal(deleted, L(0, 1, 2, 4)) || !Equal(l, L(1, 2, 3, 4)) {
+		t.Errorf("Cons after DeleteAt gave %v and changed the original to %v", deleted, l)
+	}
+}
+
+func TestSwap(t *testing.T) {
+	l := L(1, 2, 3)
+	if swapped := Swap(l, 0, 2); !Equal(swapped, L(3, 2, 1)) {
+		t.Errorf("Swap returned %v instead of [3, 2, 1]", swapped)
+	}
+}
+
+func TestIndexOutOfRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("InsertAt didn't panic on an invalid index")
+		}
+	}()
+	InsertAt(L(1, 2, 3), 4, 7)
+}